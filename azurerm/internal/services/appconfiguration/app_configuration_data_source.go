@@ -43,6 +43,11 @@ func dataSourceAppConfiguration() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"public_network_access": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"primary_read_key": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -181,6 +186,7 @@ func dataSourceAppConfigurationRead(d *pluginsdk.ResourceData, meta interface{})
 
 	if props := resp.ConfigurationStoreProperties; props != nil {
 		d.Set("endpoint", props.Endpoint)
+		d.Set("public_network_access", string(props.PublicNetworkAccess))
 	}
 
 	accessKeys := flattenAppConfigurationAccessKeys(resultPage.Values())
@@ -88,6 +88,16 @@ func resourceAppConfiguration() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"public_network_access": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(appconfiguration.Enabled),
+					string(appconfiguration.Disabled),
+				}, false),
+			},
+
 			"endpoint": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -223,6 +233,12 @@ func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{})
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if v := d.Get("public_network_access").(string); v != "" {
+		parameters.ConfigurationStoreProperties = &appconfiguration.ConfigurationStoreProperties{
+			PublicNetworkAccess: appconfiguration.PublicNetworkAccess(v),
+		}
+	}
+
 	parameters.Identity = expandAppConfigurationIdentity(d.Get("identity").([]interface{}))
 
 	future, err := client.Create(ctx, resourceGroup, name, parameters)
@@ -260,6 +276,14 @@ func resourceAppConfigurationUpdate(d *pluginsdk.ResourceData, meta interface{})
 		parameters.Identity = expandAppConfigurationIdentity(d.Get("identity").([]interface{}))
 	}
 
+	// when `public_network_access` is removed from the config the existing value is retained, since the API
+	// doesn't support resetting this to the default
+	if v := d.Get("public_network_access").(string); d.HasChange("public_network_access") && v != "" {
+		parameters.ConfigurationStorePropertiesUpdateParameters = &appconfiguration.ConfigurationStorePropertiesUpdateParameters{
+			PublicNetworkAccess: appconfiguration.PublicNetworkAccess(v),
+		}
+	}
+
 	future, err := client.Update(ctx, id.ResourceGroup, id.Name, parameters)
 	if err != nil {
 		return fmt.Errorf("Error updating App Configuration %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
@@ -321,6 +345,7 @@ func resourceAppConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) e
 
 	if props := resp.ConfigurationStoreProperties; props != nil {
 		d.Set("endpoint", props.Endpoint)
+		d.Set("public_network_access", string(props.PublicNetworkAccess))
 	}
 
 	accessKeys := flattenAppConfigurationAccessKeys(resultPage.Values())
@@ -133,14 +133,25 @@ func TestAccAppConfiguration_update(t *testing.T) {
 			Config: r.complete(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("public_network_access").HasValue("Disabled"),
 			),
 		},
 		{
 			Config: r.completeUpdated(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("public_network_access").HasValue("Enabled"),
 			),
 		},
+		data.ImportStep(),
+		{
+			Config: r.publicNetworkAccessOmitted(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("public_network_access").HasValue("Enabled"),
+			),
+		},
+		data.ImportStep(),
 	})
 }
 
@@ -229,6 +240,8 @@ resource "azurerm_app_configuration" "test" {
   location            = azurerm_resource_group.test.location
   sku                 = "standard"
 
+  public_network_access = "Disabled"
+
   tags = {
     environment = "development"
   }
@@ -275,6 +288,32 @@ resource "azurerm_resource_group" "test" {
   location = "%s"
 }
 
+resource "azurerm_app_configuration" "test" {
+  name                = "testaccappconf%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard"
+
+  public_network_access = "Enabled"
+
+  tags = {
+    Environment = "Production"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (AppConfigurationResource) publicNetworkAccessOmitted(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appconfig-%d"
+  location = "%s"
+}
+
 resource "azurerm_app_configuration" "test" {
   name                = "testaccappconf%d"
   resource_group_name = azurerm_resource_group.test.name
@@ -81,9 +81,10 @@ func resourceApplicationInsightsWebTests() *schema.Resource {
 			},
 
 			"timeout": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  30,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 120),
 			},
 
 			"enabled": {
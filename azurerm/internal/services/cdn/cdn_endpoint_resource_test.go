@@ -232,6 +232,43 @@ func TestAccCdnEndpoint_globalDeliveryRule(t *testing.T) {
 	})
 }
 
+func TestAccCdnEndpoint_globalDeliveryRuleAllActions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_endpoint", "test")
+	r := CdnEndpointResource{}
+	var endpointId string
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.globalDeliveryRuleAllActions(data, "Override", "1.00:00:00", "Found", "/index.html"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("global_delivery_rule.0.cache_expiration_action.0.behavior").HasValue("Override"),
+				check.That(data.ResourceName).Key("global_delivery_rule.0.cache_expiration_action.0.duration").HasValue("1.00:00:00"),
+				check.That(data.ResourceName).Key("global_delivery_rule.0.cache_key_query_string_action.0.behavior").HasValue("IncludeAll"),
+				check.That(data.ResourceName).Key("global_delivery_rule.0.modify_request_header_action.#").HasValue("1"),
+				check.That(data.ResourceName).Key("global_delivery_rule.0.modify_response_header_action.#").HasValue("1"),
+				check.That(data.ResourceName).Key("global_delivery_rule.0.url_redirect_action.0.redirect_type").HasValue("Found"),
+				check.That(data.ResourceName).Key("global_delivery_rule.0.url_rewrite_action.0.destination").HasValue("/index.html"),
+				r.storeId(data, &endpointId),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the actions should be updated in-place rather than the endpoint being recreated
+			Config: r.globalDeliveryRuleAllActions(data, "SetIfMissing", "2.12:00:00", "Moved", "/default.html"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("global_delivery_rule.0.cache_expiration_action.0.behavior").HasValue("SetIfMissing"),
+				check.That(data.ResourceName).Key("global_delivery_rule.0.cache_expiration_action.0.duration").HasValue("2.12:00:00"),
+				check.That(data.ResourceName).Key("global_delivery_rule.0.url_redirect_action.0.redirect_type").HasValue("Moved"),
+				check.That(data.ResourceName).Key("global_delivery_rule.0.url_rewrite_action.0.destination").HasValue("/default.html"),
+				r.idIsUnchanged(data, &endpointId),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccCdnEndpoint_deliveryRule(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_cdn_endpoint", "test")
 	r := CdnEndpointResource{}
@@ -823,6 +860,103 @@ resource "azurerm_cdn_endpoint" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+// storeId stores the ID of the CDN Endpoint so that it can later be compared using idIsUnchanged
+func (CdnEndpointResource) storeId(data acceptance.TestData, id *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+// idIsUnchanged checks that the ID of the CDN Endpoint matches the one stored by storeId
+func (CdnEndpointResource) idIsUnchanged(data acceptance.TestData, id *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+
+		if rs.Primary.ID != *id {
+			return fmt.Errorf("expected the ID of %q to be unchanged (%q) but got %q", data.ResourceName, *id, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func (r CdnEndpointResource) globalDeliveryRuleAllActions(data acceptance.TestData, behavior, duration, redirectType, rewriteDestination string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard_Microsoft"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+  name                = "acctestcdnend%d"
+  profile_name        = azurerm_cdn_profile.test.name
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  origin_host_header = "www.contoso.com"
+
+  origin {
+    name       = "acceptanceTestCdnOrigin1"
+    host_name  = "www.contoso.com"
+    https_port = 443
+    http_port  = 80
+  }
+
+  global_delivery_rule {
+    cache_expiration_action {
+      behavior = "%s"
+      duration = "%s"
+    }
+
+    cache_key_query_string_action {
+      behavior = "IncludeAll"
+    }
+
+    modify_request_header_action {
+      action = "Append"
+      name   = "X-Custom-Header"
+      value  = "acctest"
+    }
+
+    modify_response_header_action {
+      action = "Overwrite"
+      name   = "Content-Type"
+      value  = "application/json"
+    }
+
+    url_redirect_action {
+      redirect_type = "%s"
+      protocol      = "Https"
+    }
+
+    url_rewrite_action {
+      source_pattern = "/"
+      destination    = "%s"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, behavior, duration, redirectType, rewriteDestination)
+}
+
 func (r CdnEndpointResource) globalDeliveryRuleRemove(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -7,13 +7,18 @@ import (
 
 type Client struct {
 	AccountsClient *cognitiveservices.AccountsClient
+	BaseClient     *cognitiveservices.BaseClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	accountsClient := cognitiveservices.NewAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&accountsClient.Client, o.ResourceManagerAuthorizer)
 
+	baseClient := cognitiveservices.NewWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&baseClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AccountsClient: &accountsClient,
+		BaseClient:     &baseClient,
 	}
 }
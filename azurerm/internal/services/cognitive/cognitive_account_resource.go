@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/cognitiveservices/mgmt/2017-04-18/cognitiveservices"
@@ -85,6 +87,7 @@ func resourceCognitiveAccount() *schema.Resource {
 					"ImmersiveReader",
 					"LUIS",
 					"LUIS.Authoring",
+					"OpenAI",
 					"Personalizer",
 					"QnAMaker",
 					"Recommendations",
@@ -162,6 +165,11 @@ func resourceCognitiveAccount() *schema.Resource {
 				Computed: true,
 			},
 
+			"openai_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"primary_access_key": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -174,6 +182,37 @@ func resourceCognitiveAccount() *schema.Resource {
 				Sensitive: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *schema.ResourceDiff, v interface{}) error {
+			kind := d.Get("kind").(string)
+			subdomainName := d.Get("custom_subdomain_name").(string)
+			if kind == "OpenAI" && subdomainName == "" && d.NewValueKnown("custom_subdomain_name") {
+				return fmt.Errorf("`custom_subdomain_name` is required when `kind` is set to `OpenAI`")
+			}
+
+			if subdomainName == "" || !d.HasChange("custom_subdomain_name") {
+				return nil
+			}
+
+			client := v.(*clients.Client).Cognitive.BaseClient
+			parameters := cognitiveservices.CheckDomainAvailabilityParameter{
+				SubdomainName: utils.String(subdomainName),
+				Type:          utils.String("Microsoft.CognitiveServices/accounts"),
+			}
+			resp, err := client.CheckDomainAvailability(ctx, parameters)
+			if err != nil {
+				return fmt.Errorf("checking availability of `custom_subdomain_name` %q: %+v", subdomainName, err)
+			}
+			if resp.IsSubdomainAvailable != nil && !*resp.IsSubdomainAvailable {
+				reason := ""
+				if resp.Reason != nil {
+					reason = *resp.Reason
+				}
+				return fmt.Errorf("the `custom_subdomain_name` %q is not available: %s", subdomainName, reason)
+			}
+
+			return nil
+		}),
 	}
 }
 
@@ -374,6 +413,12 @@ func resourceCognitiveAccountRead(d *schema.ResourceData, meta interface{}) erro
 		}
 		d.Set("endpoint", props.Endpoint)
 		d.Set("custom_subdomain_name", props.CustomSubDomainName)
+
+		openAIEndpoint := ""
+		if resp.Kind != nil && *resp.Kind == "OpenAI" && props.CustomSubDomainName != nil && *props.CustomSubDomainName != "" && props.Endpoint != nil {
+			openAIEndpoint = cognitiveAccountOpenAIEndpoint(*props.Endpoint, *props.CustomSubDomainName)
+		}
+		d.Set("openai_endpoint", openAIEndpoint)
 		if err := d.Set("network_acls", flattenCognitiveAccountNetworkAcls(props.NetworkAcls)); err != nil {
 			return fmt.Errorf("setting `network_acls` for Cognitive Account %q: %+v", *resp.Name, err)
 		}
@@ -525,3 +570,28 @@ func flattenCognitiveAccountNetworkAcls(input *cognitiveservices.NetworkRuleSet)
 
 	return []interface{}{output}
 }
+
+// cognitiveAccountOpenAIEndpoint returns the custom subdomain format of the endpoint required by the OpenAI SDKs
+// (e.g. `https://{subdomain}.openai.azure.com/`), using the DNS suffix of the endpoint returned by the API so
+// that this works in all Azure Environments. The endpoint is returned as-is when it can't be converted.
+func cognitiveAccountOpenAIEndpoint(endpoint string, customSubDomainName string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return endpoint
+	}
+
+	segments := strings.SplitN(u.Host, ".", 2)
+	if len(segments) != 2 || !strings.EqualFold(segments[0], customSubDomainName) {
+		return endpoint
+	}
+
+	suffix := segments[1]
+	if strings.HasPrefix(strings.ToLower(suffix), "cognitiveservices.") {
+		suffix = "openai." + suffix[len("cognitiveservices."):]
+	}
+	if !strings.HasPrefix(strings.ToLower(suffix), "openai.") {
+		return endpoint
+	}
+
+	return fmt.Sprintf("%s://%s.%s/", u.Scheme, customSubDomainName, suffix)
+}
@@ -0,0 +1,54 @@
+package cognitive
+
+import "testing"
+
+func TestCognitiveAccountOpenAIEndpoint(t *testing.T) {
+	testData := []struct {
+		endpoint  string
+		subdomain string
+		expected  string
+	}{
+		{
+			endpoint:  "https://example.openai.azure.com/",
+			subdomain: "example",
+			expected:  "https://example.openai.azure.com/",
+		},
+		{
+			endpoint:  "https://example.cognitiveservices.azure.com/",
+			subdomain: "example",
+			expected:  "https://example.openai.azure.com/",
+		},
+		{
+			// Azure China
+			endpoint:  "https://example.cognitiveservices.azure.cn/",
+			subdomain: "example",
+			expected:  "https://example.openai.azure.cn/",
+		},
+		{
+			// Azure US Government
+			endpoint:  "https://example.openai.azure.us",
+			subdomain: "example",
+			expected:  "https://example.openai.azure.us/",
+		},
+		{
+			// regional endpoints can't be converted
+			endpoint:  "https://westeurope.api.cognitive.microsoft.com/",
+			subdomain: "example",
+			expected:  "https://westeurope.api.cognitive.microsoft.com/",
+		},
+		{
+			endpoint:  "not a url",
+			subdomain: "example",
+			expected:  "not a url",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.endpoint)
+
+		actual := cognitiveAccountOpenAIEndpoint(v.endpoint, v.subdomain)
+		if actual != v.expected {
+			t.Fatalf("Expected %q but got %q", v.expected, actual)
+		}
+	}
+}
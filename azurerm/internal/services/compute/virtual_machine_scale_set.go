@@ -1101,6 +1101,16 @@ func VirtualMachineScaleSetOSDiskSchema() *schema.Schema {
 									string(compute.Local),
 								}, false),
 							},
+							"placement": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+								Default:  string(compute.CacheDisk),
+								ValidateFunc: validation.StringInSlice([]string{
+									string(compute.CacheDisk),
+									string(compute.ResourceDisk),
+								}, false),
+							},
 						},
 					},
 				},
@@ -1159,7 +1169,8 @@ func ExpandVirtualMachineScaleSetOSDisk(input []interface{}, osType compute.Oper
 	if diffDiskSettingsRaw := raw["diff_disk_settings"].([]interface{}); len(diffDiskSettingsRaw) > 0 {
 		diffDiskRaw := diffDiskSettingsRaw[0].(map[string]interface{})
 		disk.DiffDiskSettings = &compute.DiffDiskSettings{
-			Option: compute.DiffDiskOptions(diffDiskRaw["option"].(string)),
+			Option:    compute.DiffDiskOptions(diffDiskRaw["option"].(string)),
+			Placement: compute.DiffDiskPlacement(diffDiskRaw["placement"].(string)),
 		}
 	}
 
@@ -1196,8 +1207,15 @@ func FlattenVirtualMachineScaleSetOSDisk(input *compute.VirtualMachineScaleSetOS
 
 	diffDiskSettings := make([]interface{}, 0)
 	if input.DiffDiskSettings != nil {
+		// older Scale Sets don't return the placement, in which case the Cache Disk is used
+		placement := string(compute.CacheDisk)
+		if input.DiffDiskSettings.Placement != "" {
+			placement = string(input.DiffDiskSettings.Placement)
+		}
+
 		diffDiskSettings = append(diffDiskSettings, map[string]interface{}{
-			"option": string(input.DiffDiskSettings.Option),
+			"option":    string(input.DiffDiskSettings.Option),
+			"placement": placement,
 		})
 	}
 
@@ -1386,7 +1404,7 @@ func VirtualMachineScaleSetTerminateNotificationSchema() *schema.Schema {
 				"timeout": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					ValidateFunc: azValidate.ISO8601Duration,
+					ValidateFunc: azValidate.ISO8601DurationBetween("PT5M", "PT15M"),
 					Default:      "PT5M",
 				},
 			},
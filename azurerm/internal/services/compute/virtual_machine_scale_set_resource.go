@@ -234,6 +234,8 @@ func resourceVirtualMachineScaleSet() *schema.Resource {
 				}, false),
 			},
 
+			"terminate_notification": VirtualMachineScaleSetTerminateNotificationSchema(),
+
 			"os_profile": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -881,6 +883,10 @@ func resourceVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta int
 		scaleSetProps.VirtualMachineProfile.EvictionPolicy = compute.VirtualMachineEvictionPolicyTypes(evictionPolicy)
 	}
 
+	if v, ok := d.GetOk("terminate_notification"); ok {
+		scaleSetProps.VirtualMachineProfile.ScheduledEventsProfile = ExpandVirtualMachineScaleSetScheduledEventsProfile(v.([]interface{}))
+	}
+
 	if _, ok := d.GetOk("boot_diagnostics"); ok {
 		diagnosticProfile := expandAzureRMVirtualMachineScaleSetsDiagnosticProfile(d)
 		scaleSetProps.VirtualMachineProfile.DiagnosticsProfile = &diagnosticProfile
@@ -1007,6 +1013,10 @@ func resourceVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}
 			d.Set("priority", string(profile.Priority))
 			d.Set("eviction_policy", string(profile.EvictionPolicy))
 
+			if err := d.Set("terminate_notification", FlattenVirtualMachineScaleSetScheduledEventsProfile(profile.ScheduledEventsProfile)); err != nil {
+				return fmt.Errorf("[DEBUG] Error setting `terminate_notification`: %#v", err)
+			}
+
 			osProfile := flattenAzureRMVirtualMachineScaleSetOsProfile(d, profile.OsProfile)
 			if err := d.Set("os_profile", osProfile); err != nil {
 				return fmt.Errorf("[DEBUG] Error setting `os_profile`: %#v", err)
@@ -72,6 +72,55 @@ func TestAccVirtualMachineScaleSet_evictionPolicyDelete(t *testing.T) {
 	})
 }
 
+func TestAccVirtualMachineScaleSet_terminateNotification(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set", "test")
+	r := VirtualMachineScaleSetResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.terminateNotification(data, true, "PT10M"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("terminate_notification.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("terminate_notification.0.timeout").HasValue("PT10M"),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+		{
+			Config: r.terminateNotification(data, false, "PT10M"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("terminate_notification.0.enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+		{
+			Config: r.terminateNotification(data, true, "PT10M"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("terminate_notification.0.enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+	})
+}
+
+func TestAccVirtualMachineScaleSet_terminateNotificationTimeoutOutOfRange(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set", "test")
+	r := VirtualMachineScaleSetResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.terminateNotification(data, true, "PT1M"),
+			ExpectError: regexp.MustCompile("expected terminate_notification.0.timeout to be in the range"),
+		},
+		{
+			Config:      r.terminateNotification(data, true, "PT1H"),
+			ExpectError: regexp.MustCompile("expected terminate_notification.0.timeout to be in the range"),
+		},
+	})
+}
+
 func TestAccVirtualMachineScaleSet_standardSSD(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set", "test")
 	r := VirtualMachineScaleSetResource{}
@@ -1141,6 +1190,82 @@ resource "azurerm_virtual_machine_scale_set" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (VirtualMachineScaleSetResource) terminateNotification(data acceptance.TestData, enabled bool, timeout string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_virtual_machine_scale_set" "test" {
+  name                = "acctvmss-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  upgrade_policy_mode = "Manual"
+
+  sku {
+    name     = "Standard_D1_v2"
+    tier     = "Standard"
+    capacity = 2
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%[1]d"
+    admin_username       = "myadmin"
+    admin_password       = "Passwword1234"
+  }
+
+  network_profile {
+    name    = "TestNetworkProfile-%[1]d"
+    primary = true
+
+    ip_configuration {
+      name      = "TestIPConfiguration"
+      primary   = true
+      subnet_id = azurerm_subnet.test.id
+    }
+  }
+
+  storage_profile_os_disk {
+    name              = ""
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  terminate_notification {
+    enabled = %[3]t
+    timeout = "%[4]s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, enabled, timeout)
+}
+
 func (VirtualMachineScaleSetResource) standardSSD(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
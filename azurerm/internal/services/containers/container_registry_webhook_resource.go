@@ -79,9 +79,10 @@ func resourceContainerRegistryWebhook() *schema.Resource {
 			},
 
 			"scope": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validate.ContainerRegistryWebhookScope,
 			},
 
 			"actions": {
@@ -136,6 +136,13 @@ func TestAccContainerRegistryWebhook_scope(t *testing.T) {
 				check.That(data.ResourceName).Key("scope").HasValue("mytag:4"),
 			),
 		},
+		{
+			Config: r.scopeWildcard(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scope").HasValue("*"),
+			),
+		},
 	})
 }
 
@@ -547,6 +554,41 @@ resource "azurerm_container_registry_webhook" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary)
 }
 
+func (ContainerRegistryWebhookResource) scopeWildcard(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "rg" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "acr" {
+  name                = "acrwebhooktest%d"
+  resource_group_name = azurerm_resource_group.rg.name
+  location            = "%s"
+  sku                 = "Standard"
+}
+
+resource "azurerm_container_registry_webhook" "test" {
+  name                = "testwebhook%d"
+  resource_group_name = azurerm_resource_group.rg.name
+  registry_name       = azurerm_container_registry.acr.name
+  location            = "%s"
+
+  service_uri = "https://mywebhookreceiver.example/mytag"
+
+  scope = "*"
+
+  actions = [
+    "push"
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary)
+}
+
 func (ContainerRegistryWebhookResource) customHeaders(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
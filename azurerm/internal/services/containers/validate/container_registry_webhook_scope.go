@@ -0,0 +1,27 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ContainerRegistryWebhookScope validates the scope of a Container Registry Webhook, which is either empty
+// (all events) or a `repository` / `repository:tag` pattern - where either part may contain `*` as a wildcard
+func ContainerRegistryWebhookScope(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return warnings, errors
+	}
+
+	if value == "" {
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-z0-9*]([a-z0-9._/*-]*[a-z0-9*])?(:[a-zA-Z0-9_*][a-zA-Z0-9_.*-]{0,127})?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be in the format `repository` or `repository:tag`, where the repository may only contain lowercase letters, numbers, `.`, `_`, `-`, `/` and `*` and the tag may only contain letters, numbers, `.`, `_`, `-` and `*`: %q", k, value))
+	}
+
+	return warnings, errors
+}
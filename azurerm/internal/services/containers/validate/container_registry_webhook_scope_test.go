@@ -0,0 +1,75 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers/validate"
+)
+
+func TestContainerRegistryWebhookScope(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 0,
+		},
+		{
+			Value:    "*",
+			ErrCount: 0,
+		},
+		{
+			Value:    "mytag",
+			ErrCount: 0,
+		},
+		{
+			Value:    "mytag:*",
+			ErrCount: 0,
+		},
+		{
+			Value:    "mytag:4",
+			ErrCount: 0,
+		},
+		{
+			Value:    "samples/hello-world:v1.0",
+			ErrCount: 0,
+		},
+		{
+			Value:    "samples/*:latest",
+			ErrCount: 0,
+		},
+		{
+			Value:    "MyTag:4",
+			ErrCount: 1,
+		},
+		{
+			Value:    "mytag:",
+			ErrCount: 1,
+		},
+		{
+			Value:    "my tag:*",
+			ErrCount: 1,
+		},
+		{
+			Value:    "mytag:1:2",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-mytag",
+			ErrCount: 1,
+		},
+		{
+			Value:    "mytag:$latest",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerRegistryWebhookScope(tc.Value, "scope")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure Container Registry Webhook Scope %q to trigger %d validation errors, got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package eventgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type EventGridSystemTopicDataSource struct {
+}
+
+func TestAccEventGridSystemTopicDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_eventgrid_system_topic", "test")
+	r := EventGridSystemTopicDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("source_arm_resource_id").Exists(),
+				check.That(data.ResourceName).Key("topic_type").HasValue("Microsoft.Storage.StorageAccounts"),
+				check.That(data.ResourceName).Key("metric_arm_resource_id").Exists(),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+				check.That(data.ResourceName).Key("tags.Foo").HasValue("Bar"),
+			),
+		},
+	})
+}
+
+func (EventGridSystemTopicDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_eventgrid_system_topic" "test" {
+  name                = azurerm_eventgrid_system_topic.test.name
+  resource_group_name = azurerm_eventgrid_system_topic.test.resource_group_name
+}
+`, EventGridSystemTopicResource{}.complete(data))
+}
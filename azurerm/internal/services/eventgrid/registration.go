@@ -23,6 +23,7 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"azurerm_eventgrid_topic":        dataSourceEventGridTopic(),
 		"azurerm_eventgrid_domain_topic": dataSourceEventGridDomainTopic(),
+		"azurerm_eventgrid_system_topic": dataSourceEventGridSystemTopic(),
 	}
 }
 
@@ -370,6 +370,36 @@ func TestAccFirewallApplicationRuleCollection_noSource(t *testing.T) {
 	})
 }
 
+func TestAccFirewallApplicationRuleCollection_fqdnTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_application_rule_collection", "test")
+	r := FirewallApplicationRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.fqdnTags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rule.0.fqdn_tags.#").HasValue("2"),
+				check.That(data.ResourceName).Key("rule.0.target_fqdns.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallApplicationRuleCollection_fqdnTagsAndTargetFqdns(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_application_rule_collection", "test")
+	r := FirewallApplicationRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.fqdnTagsAndTargetFqdns(data),
+			ExpectError: regexp.MustCompile("`fqdn_tags` cannot be used with `target_fqdns` or `protocol`"),
+		},
+	})
+}
+
 func (FirewallApplicationRuleCollectionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	var id, err = azure.ParseAzureResourceID(state.ID)
 	if err != nil {
@@ -882,3 +912,60 @@ resource "azurerm_firewall_application_rule_collection" "test" {
 }
 `, FirewallResource{}.basic(data))
 }
+
+func (FirewallApplicationRuleCollectionResource) fqdnTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_application_rule_collection" "test" {
+  name                = "acctestarc"
+  azure_firewall_name = azurerm_firewall.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  priority            = 100
+  action              = "Allow"
+
+  rule {
+    name = "rule1"
+
+    source_addresses = [
+      "10.0.0.0/16",
+    ]
+
+    fqdn_tags = [
+      "WindowsDiagnostics",
+      "WindowsUpdate",
+    ]
+  }
+}
+`, FirewallResource{}.basic(data))
+}
+
+func (FirewallApplicationRuleCollectionResource) fqdnTagsAndTargetFqdns(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_application_rule_collection" "test" {
+  name                = "acctestarc"
+  azure_firewall_name = azurerm_firewall.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  priority            = 100
+  action              = "Allow"
+
+  rule {
+    name = "rule1"
+
+    source_addresses = [
+      "10.0.0.0/16",
+    ]
+
+    fqdn_tags = [
+      "WindowsUpdate",
+    ]
+
+    target_fqdns = [
+      "*.google.com",
+    ]
+  }
+}
+`, FirewallResource{}.basic(data))
+}
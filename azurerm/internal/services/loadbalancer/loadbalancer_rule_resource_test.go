@@ -3,6 +3,7 @@ package loadbalancer_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -79,6 +80,28 @@ func TestAccAzureRMLoadBalancerRule_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMLoadBalancerRule_haPorts(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lb_rule", "test")
+	r := LoadBalancerRule{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.haPorts(data, 80, 80),
+			ExpectError: regexp.MustCompile("`frontend_port` and `backend_port` must both be set to `0` when `protocol` is `All`"),
+		},
+		{
+			Config: r.haPorts(data, 0, 0),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protocol").HasValue("All"),
+				check.That(data.ResourceName).Key("frontend_port").HasValue("0"),
+				check.That(data.ResourceName).Key("backend_port").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAzureRMLoadBalancerRule_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_lb_rule", "test")
 	r := LoadBalancerRule{}
@@ -313,6 +336,63 @@ resource "azurerm_lb_rule" "test" {
 `, template, data.RandomStringOfLength(8))
 }
 
+func (r LoadBalancerRule) haPorts(data acceptance.TestData, frontendPort, backendPort int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-lb-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_lb" "test" {
+  name                = "arm-test-loadbalancer-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+
+  frontend_ip_configuration {
+    name                          = "internal-%[1]d"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "test" {
+  name            = "nva-%[1]d"
+  loadbalancer_id = azurerm_lb.test.id
+}
+
+resource "azurerm_lb_rule" "test" {
+  name                           = "LbRule-%[3]s"
+  resource_group_name            = azurerm_resource_group.test.name
+  loadbalancer_id                = azurerm_lb.test.id
+  frontend_ip_configuration_name = azurerm_lb.test.frontend_ip_configuration.0.name
+  backend_address_pool_id        = azurerm_lb_backend_address_pool.test.id
+  protocol                       = "All"
+  frontend_port                  = %[4]d
+  backend_port                   = %[5]d
+  enable_floating_ip             = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomStringOfLength(8), frontendPort, backendPort)
+}
+
 func (r LoadBalancerRule) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -1,8 +1,10 @@
 package loadbalancer
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
@@ -15,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loadbalancer/parse"
 	loadBalancerValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loadbalancer/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -44,6 +47,8 @@ func resourceArmLoadBalancerRule() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceArmLoadBalancerRuleCustomizeDiff),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -140,6 +145,19 @@ func resourceArmLoadBalancerRule() *schema.Resource {
 	}
 }
 
+func resourceArmLoadBalancerRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// HA Ports rules load balance all ports, which the API requires to be expressed as port `0`
+	if strings.EqualFold(d.Get("protocol").(string), string(network.TransportProtocolAll)) {
+		frontendPort := d.Get("frontend_port").(int)
+		backendPort := d.Get("backend_port").(int)
+		if frontendPort != 0 || backendPort != 0 {
+			return fmt.Errorf("`frontend_port` and `backend_port` must both be set to `0` when `protocol` is `All` (HA Ports) - got `frontend_port` %d and `backend_port` %d", frontendPort, backendPort)
+		}
+	}
+
+	return nil
+}
+
 func resourceArmLoadBalancerRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).LoadBalancers.LoadBalancersClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
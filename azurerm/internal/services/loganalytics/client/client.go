@@ -16,6 +16,7 @@ type Client struct {
 	SharedKeysClient           *operationalinsights.SharedKeysClient
 	SolutionsClient            *operationsmanagement.SolutionsClient
 	StorageInsightsClient      *operationalinsights.StorageInsightConfigsClient
+	TablesClient               *operationalinsights.TablesClient
 	WorkspacesClient           *operationalinsights.WorkspacesClient
 }
 
@@ -44,6 +45,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	StorageInsightsClient := operationalinsights.NewStorageInsightConfigsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&StorageInsightsClient.Client, o.ResourceManagerAuthorizer)
 
+	TablesClient := operationalinsights.NewTablesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&TablesClient.Client, o.ResourceManagerAuthorizer)
+
 	LinkedServicesClient := operationalinsights.NewLinkedServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&LinkedServicesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -60,6 +64,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		SharedKeysClient:           &SharedKeysClient,
 		SolutionsClient:            &SolutionsClient,
 		StorageInsightsClient:      &StorageInsightsClient,
+		TablesClient:               &TablesClient,
 		WorkspacesClient:           &WorkspacesClient,
 	}
 }
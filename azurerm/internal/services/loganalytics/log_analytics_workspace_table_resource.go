@@ -0,0 +1,154 @@
+package loganalytics
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceLogAnalyticsWorkspaceTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLogAnalyticsWorkspaceTableCreateUpdate,
+		Read:   resourceLogAnalyticsWorkspaceTableRead,
+		Update: resourceLogAnalyticsWorkspaceTableCreateUpdate,
+		Delete: resourceLogAnalyticsWorkspaceTableDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.LogAnalyticsWorkspaceTableID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogAnalyticsWorkspaceID,
+			},
+
+			"retention_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(30, 730),
+			},
+		},
+	}
+}
+
+func resourceLogAnalyticsWorkspaceTableCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspace, err := parse.LogAnalyticsWorkspaceID(d.Get("workspace_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewLogAnalyticsWorkspaceTableID(workspace.SubscriptionId, workspace.ResourceGroup, workspace.WorkspaceName, d.Get("name").(string))
+
+	// tables are created by the service when data is first ingested, so this resource only ever updates them
+	existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.TableName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	parameters := operationalinsights.Table{
+		TableProperties: &operationalinsights.TableProperties{},
+	}
+
+	if v, ok := d.GetOk("retention_in_days"); ok {
+		parameters.TableProperties.RetentionInDays = utils.Int32(int32(v.(int)))
+	} else if existing.TableProperties != nil {
+		parameters.TableProperties.RetentionInDays = existing.TableProperties.RetentionInDays
+	}
+
+	if _, err := client.Update(ctx, id.ResourceGroup, id.WorkspaceName, id.TableName, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceLogAnalyticsWorkspaceTableRead(d, meta)
+}
+
+func resourceLogAnalyticsWorkspaceTableRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogAnalyticsWorkspaceTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.TableName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TableName)
+	d.Set("workspace_id", parse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	if props := resp.TableProperties; props != nil {
+		retentionInDays := 0
+		if props.RetentionInDays != nil {
+			retentionInDays = int(*props.RetentionInDays)
+		}
+		d.Set("retention_in_days", retentionInDays)
+	}
+
+	return nil
+}
+
+func resourceLogAnalyticsWorkspaceTableDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogAnalyticsWorkspaceTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// tables can't be deleted, instead we reset the retention so that the workspace default applies again
+	parameters := operationalinsights.Table{
+		TableProperties: &operationalinsights.TableProperties{
+			RetentionInDays: utils.Int32(-1),
+		},
+	}
+
+	if _, err := client.Update(ctx, id.ResourceGroup, id.WorkspaceName, id.TableName, parameters); err != nil {
+		return fmt.Errorf("resetting retention for %s: %+v", *id, err)
+	}
+
+	return nil
+}
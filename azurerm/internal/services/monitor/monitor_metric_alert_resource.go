@@ -372,7 +372,35 @@ func resourceMonitorMetricAlert() *schema.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceMonitorMetricAlertCustomizeDiff),
+	}
+}
+
+func resourceMonitorMetricAlertCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("dynamic_criteria") {
+		return nil
+	}
+
+	for _, item := range d.Get("dynamic_criteria").(*schema.Set).List() {
+		v, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// values which aren't known yet are returned as `0`, which isn't otherwise valid for either field
+		totalCount := v["evaluation_total_count"].(int)
+		failureCount := v["evaluation_failure_count"].(int)
+		if totalCount == 0 || failureCount == 0 {
+			continue
+		}
+
+		if failureCount > totalCount {
+			return fmt.Errorf("`evaluation_failure_count` (%d) must be less than or equal to `evaluation_total_count` (%d) within `dynamic_criteria`", failureCount, totalCount)
+		}
 	}
+
+	return nil
 }
 
 func resourceMonitorMetricAlertCreateUpdate(d *schema.ResourceData, meta interface{}) error {
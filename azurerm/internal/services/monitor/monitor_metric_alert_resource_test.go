@@ -3,6 +3,9 @@ package monitor_test
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -120,6 +123,44 @@ func TestAccMonitorMetricAlert_multiScope(t *testing.T) {
 	})
 }
 
+func TestAccMonitorMetricAlert_dynamicCriteriaEvaluationCount(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_metric_alert", "test")
+	r := MonitorMetricAlertResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.dynamicCriteriaEvaluationCount(data, 2, 4),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dynamic_criteria.#").HasValue("1"),
+				r.hasDynamicCriteriaEvaluationCounts(data, 2, 4),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.dynamicCriteriaEvaluationCount(data, 3, 6),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dynamic_criteria.#").HasValue("1"),
+				r.hasDynamicCriteriaEvaluationCounts(data, 3, 6),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorMetricAlert_dynamicCriteriaEvaluationFailureCountTooLarge(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_metric_alert", "test")
+	r := MonitorMetricAlertResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.dynamicCriteriaEvaluationCount(data, 5, 4),
+			ExpectError: regexp.MustCompile("`evaluation_failure_count` \\(5\\) must be less than or equal to `evaluation_total_count` \\(4\\)"),
+		},
+	})
+}
+
 func TestAccMonitorMetricAlert_applicationInsightsWebTest(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_monitor_metric_alert", "test")
 	r := MonitorMetricAlertResource{}
@@ -151,6 +192,44 @@ func (t MonitorMetricAlertResource) Exists(ctx context.Context, clients *clients
 	return utils.Bool(resp.ID != nil), nil
 }
 
+// hasDynamicCriteriaEvaluationCounts checks the evaluation counts of the `dynamic_criteria` block in the state - since
+// `dynamic_criteria` is a Set the index of the block within the state is a hash, rather than `0`
+func (MonitorMetricAlertResource) hasDynamicCriteriaEvaluationCounts(data acceptance.TestData, failureCount, totalCount int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+
+		expected := map[string]string{
+			"evaluation_failure_count": strconv.Itoa(failureCount),
+			"evaluation_total_count":   strconv.Itoa(totalCount),
+		}
+		found := 0
+		for key, value := range rs.Primary.Attributes {
+			segments := strings.Split(key, ".")
+			if len(segments) != 3 || segments[0] != "dynamic_criteria" {
+				continue
+			}
+
+			expectedValue, ok := expected[segments[2]]
+			if !ok {
+				continue
+			}
+			if value != expectedValue {
+				return fmt.Errorf("expected `dynamic_criteria.%s.%s` to be %q but got %q", segments[1], segments[2], expectedValue, value)
+			}
+			found++
+		}
+
+		if found != len(expected) {
+			return fmt.Errorf("expected to find `evaluation_failure_count` and `evaluation_total_count` within `dynamic_criteria` but found %d of them", found)
+		}
+
+		return nil
+	}
+}
+
 func (MonitorMetricAlertResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -277,6 +356,46 @@ resource "azurerm_monitor_metric_alert" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
+func (MonitorMetricAlertResource) dynamicCriteriaEvaluationCount(data acceptance.TestData, failureCount, totalCount int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_monitor_metric_alert" "test" {
+  name                = "acctestMetricAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_storage_account.test.id]
+
+  dynamic_criteria {
+    metric_namespace         = "Microsoft.Storage/storageAccounts"
+    metric_name              = "Transactions"
+    aggregation              = "Total"
+    operator                 = "GreaterThan"
+    alert_sensitivity        = "Medium"
+    evaluation_failure_count = %d
+    evaluation_total_count   = %d
+  }
+
+  window_size = "PT5M"
+  frequency   = "PT5M"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger, failureCount, totalCount)
+}
+
 func (MonitorMetricAlertResource) multiVMTemplate(data acceptance.TestData, count int) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
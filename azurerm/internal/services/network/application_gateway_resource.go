@@ -808,6 +808,12 @@ func resourceApplicationGateway() *schema.Resource {
 				Optional: true,
 			},
 
+			"fips_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"probe": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -1377,6 +1383,7 @@ func resourceApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interfa
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	enablehttp2 := d.Get("enable_http2").(bool)
+	fipsEnabled := d.Get("fips_enabled").(bool)
 	t := d.Get("tags").(map[string]interface{})
 
 	// Gateway ID is needed to link sub-resources together in expand functions
@@ -1427,6 +1434,7 @@ func resourceApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interfa
 			BackendAddressPools:           expandApplicationGatewayBackendAddressPools(d),
 			BackendHTTPSettingsCollection: expandApplicationGatewayBackendHTTPSettings(d, id.ID()),
 			EnableHTTP2:                   utils.Bool(enablehttp2),
+			EnableFips:                    utils.Bool(fipsEnabled),
 			FrontendIPConfigurations:      expandApplicationGatewayFrontendIPConfigurations(d),
 			FrontendPorts:                 expandApplicationGatewayFrontendPorts(d),
 			GatewayIPConfigurations:       gatewayIPConfigurations,
@@ -1591,6 +1599,7 @@ func resourceApplicationGatewayRead(d *schema.ResourceData, meta interface{}) er
 		}
 
 		d.Set("enable_http2", props.EnableHTTP2)
+		d.Set("fips_enabled", props.EnableFips)
 
 		httpListeners, err := flattenApplicationGatewayHTTPListeners(props.HTTPListeners)
 		if err != nil {
@@ -3896,6 +3905,10 @@ func applicationGatewayCustomizeDiff(ctx context.Context, d *schema.ResourceDiff
 		return fmt.Errorf("The Application Gateway must specify either `capacity` or `autoscale_configuration` for the selected SKU tier %q", tier)
 	}
 
+	if d.Get("fips_enabled").(bool) && !strings.EqualFold(tier, string(network.ApplicationGatewayTierStandardV2)) && !strings.EqualFold(tier, string(network.ApplicationGatewayTierWAFV2)) {
+		return fmt.Errorf("`fips_enabled` can only be set to `true` when the SKU tier is %q or %q, got %q", string(network.ApplicationGatewayTierStandardV2), string(network.ApplicationGatewayTierWAFV2), tier)
+	}
+
 	if hasCapacity {
 		if (strings.EqualFold(tier, string(network.ApplicationGatewayTierStandard)) || strings.EqualFold(tier, string(network.ApplicationGatewayTierWAF))) && (capacity.(int) < 1 || capacity.(int) > 32) {
 			return fmt.Errorf("The value '%d' exceeds the maximum capacity allowed for a %q V1 SKU, the %q SKU must have a capacity value between 1 and 32", capacity, tier, tier)
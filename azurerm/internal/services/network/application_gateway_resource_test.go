@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
@@ -143,6 +144,37 @@ func TestAccApplicationGateway_http2(t *testing.T) {
 	})
 }
 
+func TestAccApplicationGateway_fipsEnabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway", "test")
+	r := ApplicationGatewayResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.fipsEnabled(data, "Standard_v2", "Standard_v2"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fips_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("ssl_policy.0.policy_type").HasValue("Predefined"),
+				check.That(data.ResourceName).Key("ssl_policy.0.policy_name").HasValue("AppGwSslPolicy20170401S"),
+				data.CheckWithClient(r.sslPolicyIsFipsCompliant),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGateway_fipsEnabledV1Tier(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway", "test")
+	r := ApplicationGatewayResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.fipsEnabled(data, "Standard_Small", "Standard"),
+			ExpectError: regexp.MustCompile("`fips_enabled` can only be set to `true` when the SKU tier is"),
+		},
+	})
+}
+
 func TestAccApplicationGateway_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_application_gateway", "test")
 	r := ApplicationGatewayResource{}
@@ -1518,6 +1550,90 @@ resource "azurerm_application_gateway" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r ApplicationGatewayResource) fipsEnabled(data acceptance.TestData, skuName, tier string) string {
+	return fmt.Sprintf(`
+%s
+
+# since these variables are re-used - a locals block makes this more maintainable
+locals {
+  backend_address_pool_name      = "${azurerm_virtual_network.test.name}-beap"
+  frontend_port_name             = "${azurerm_virtual_network.test.name}-feport"
+  frontend_ip_configuration_name = "${azurerm_virtual_network.test.name}-feip"
+  http_setting_name              = "${azurerm_virtual_network.test.name}-be-htst"
+  listener_name                  = "${azurerm_virtual_network.test.name}-httplstn"
+  request_routing_rule_name      = "${azurerm_virtual_network.test.name}-rqrt"
+}
+
+resource "azurerm_public_ip" "test_standard" {
+  name                = "acctest-pubip-%d-standard"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+  allocation_method   = "Static"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestag-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  fips_enabled        = true
+
+  sku {
+    name     = "%s"
+    tier     = "%s"
+    capacity = 2
+  }
+
+  ssl_policy {
+    policy_type = "Predefined"
+    policy_name = "AppGwSslPolicy20170401S"
+  }
+
+  gateway_ip_configuration {
+    name      = "my-gateway-ip-configuration"
+    subnet_id = azurerm_subnet.test.id
+  }
+
+  frontend_port {
+    name = local.frontend_port_name
+    port = 80
+  }
+
+  frontend_ip_configuration {
+    name                 = local.frontend_ip_configuration_name
+    public_ip_address_id = azurerm_public_ip.test_standard.id
+  }
+
+  backend_address_pool {
+    name = local.backend_address_pool_name
+  }
+
+  backend_http_settings {
+    name                  = local.http_setting_name
+    cookie_based_affinity = "Disabled"
+    port                  = 80
+    protocol              = "Http"
+    request_timeout       = 1
+  }
+
+  http_listener {
+    name                           = local.listener_name
+    frontend_ip_configuration_name = local.frontend_ip_configuration_name
+    frontend_port_name             = local.frontend_port_name
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name                       = local.request_routing_rule_name
+    rule_type                  = "Basic"
+    http_listener_name         = local.listener_name
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, skuName, tier)
+}
+
 func (r ApplicationGatewayResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -3960,6 +4076,61 @@ resource "azurerm_application_gateway" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+// sslPolicyIsFipsCompliant confirms that the effective SSL Policy of the Application Gateway (as returned by
+// the API) only allows TLS 1.2 and above, and only cipher suites using FIPS-approved algorithms
+func (ApplicationGatewayResource) sslPolicyIsFipsCompliant(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
+	id, err := parse.ApplicationGatewayID(state.ID)
+	if err != nil {
+		return err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.ApplicationGatewayPropertiesFormat == nil || resp.ApplicationGatewayPropertiesFormat.SslPolicy == nil {
+		return fmt.Errorf("retrieving %s: `properties.sslPolicy` was nil", *id)
+	}
+	if resp.ApplicationGatewayPropertiesFormat.EnableFips == nil || !*resp.ApplicationGatewayPropertiesFormat.EnableFips {
+		return fmt.Errorf("expected FIPS to be enabled for %s", *id)
+	}
+
+	policy := *resp.ApplicationGatewayPropertiesFormat.SslPolicy
+	minProtocolVersion := policy.MinProtocolVersion
+	cipherSuites := policy.CipherSuites
+	if policy.PolicyType == network.Predefined {
+		predefined, err := clients.Network.ApplicationGatewaysClient.GetSslPredefinedPolicy(ctx, string(policy.PolicyName))
+		if err != nil {
+			return fmt.Errorf("retrieving Predefined SSL Policy %q: %+v", string(policy.PolicyName), err)
+		}
+		if predefined.ApplicationGatewaySslPredefinedPolicyPropertiesFormat == nil {
+			return fmt.Errorf("retrieving Predefined SSL Policy %q: `properties` was nil", string(policy.PolicyName))
+		}
+		minProtocolVersion = predefined.ApplicationGatewaySslPredefinedPolicyPropertiesFormat.MinProtocolVersion
+		cipherSuites = predefined.ApplicationGatewaySslPredefinedPolicyPropertiesFormat.CipherSuites
+	}
+
+	if minProtocolVersion != network.TLSv12 {
+		return fmt.Errorf("expected the minimum protocol version of the SSL Policy to be %q but got %q", string(network.TLSv12), string(minProtocolVersion))
+	}
+	if cipherSuites == nil || len(*cipherSuites) == 0 {
+		return fmt.Errorf("expected the SSL Policy to define Cipher Suites")
+	}
+	for _, cipherSuite := range *cipherSuites {
+		name := string(cipherSuite)
+		if !strings.Contains(name, "_AES_128_") && !strings.Contains(name, "_AES_256_") {
+			return fmt.Errorf("Cipher Suite %q doesn't use a FIPS-approved (AES) cipher", name)
+		}
+		for _, disallowed := range []string{"_RC4_", "_DES_", "_3DES_", "_NULL_", "_MD5"} {
+			if strings.Contains(name, disallowed) {
+				return fmt.Errorf("Cipher Suite %q uses a non FIPS-approved algorithm", name)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (ApplicationGatewayResource) changeCert(certificateName string) acceptance.ClientCheckFunc {
 	return func(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
 		gatewayName := state.Attributes["name"]
@@ -0,0 +1,207 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceExpressRouteCircuitPeering() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceExpressRouteCircuitPeeringRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"peering_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.AzurePrivatePeering),
+					string(network.AzurePublicPeering),
+					string(network.MicrosoftPeering),
+				}, false),
+			},
+
+			"express_route_circuit_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"primary_peer_address_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_peer_address_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"vlan_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"peer_asn": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"microsoft_peering_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"advertised_public_prefixes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"customer_asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"routing_registry_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"ipv6": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"microsoft_peering": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"advertised_public_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"customer_asn": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+
+									"routing_registry_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"primary_peer_address_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"secondary_peer_address_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"route_filter_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"azure_asn": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"primary_azure_port": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_azure_port": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"route_filter_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceExpressRouteCircuitPeeringRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRoutePeeringsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	peeringType := d.Get("peering_type").(string)
+	circuitName := d.Get("express_route_circuit_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, circuitName, peeringType)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Express Route Circuit Peering %q (Circuit %q / Resource Group %q) was not found", peeringType, circuitName, resourceGroup)
+		}
+		return fmt.Errorf("Error making Read request on Express Route Circuit Peering %q (Circuit %q / Resource Group %q): %+v", peeringType, circuitName, resourceGroup, err)
+	}
+
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("Error retrieving Express Route Circuit Peering %q (Circuit %q / Resource Group %q): `id` was nil", peeringType, circuitName, resourceGroup)
+	}
+	d.SetId(*resp.ID)
+
+	d.Set("peering_type", peeringType)
+	d.Set("express_route_circuit_name", circuitName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.ExpressRouteCircuitPeeringPropertiesFormat; props != nil {
+		d.Set("azure_asn", props.AzureASN)
+		d.Set("peer_asn", props.PeerASN)
+		d.Set("primary_azure_port", props.PrimaryAzurePort)
+		d.Set("secondary_azure_port", props.SecondaryAzurePort)
+		d.Set("primary_peer_address_prefix", props.PrimaryPeerAddressPrefix)
+		d.Set("secondary_peer_address_prefix", props.SecondaryPeerAddressPrefix)
+		d.Set("vlan_id", props.VlanID)
+
+		routeFilterId := ""
+		if props.RouteFilter != nil && props.RouteFilter.ID != nil {
+			routeFilterId = *props.RouteFilter.ID
+		}
+		d.Set("route_filter_id", routeFilterId)
+
+		if err := d.Set("microsoft_peering_config", flattenExpressRouteCircuitPeeringMicrosoftConfig(props.MicrosoftPeeringConfig)); err != nil {
+			return fmt.Errorf("setting `microsoft_peering_config`: %+v", err)
+		}
+		if err := d.Set("ipv6", flattenExpressRouteCircuitIpv6PeeringConfig(props.Ipv6PeeringConfig)); err != nil {
+			return fmt.Errorf("setting `ipv6`: %+v", err)
+		}
+	}
+
+	return nil
+}
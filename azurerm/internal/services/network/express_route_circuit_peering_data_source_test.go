@@ -0,0 +1,78 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type ExpressRouteCircuitPeeringDataSource struct {
+}
+
+func testAccDataSourceExpressRouteCircuitPeering_privatePeering(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_express_route_circuit_peering", "test")
+	r := ExpressRouteCircuitPeeringDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.privatePeering(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("peering_type").HasValue("AzurePrivatePeering"),
+				check.That(data.ResourceName).Key("vlan_id").HasValue("100"),
+				check.That(data.ResourceName).Key("peer_asn").HasValue("100"),
+				check.That(data.ResourceName).Key("primary_peer_address_prefix").HasValue("192.168.1.0/30"),
+				check.That(data.ResourceName).Key("secondary_peer_address_prefix").HasValue("192.168.2.0/30"),
+				check.That(data.ResourceName).Key("azure_asn").MatchesOtherKey(check.That("azurerm_express_route_circuit_peering.test").Key("azure_asn")),
+				check.That(data.ResourceName).Key("microsoft_peering_config.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func testAccDataSourceExpressRouteCircuitPeering_microsoftPeering(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_express_route_circuit_peering", "test")
+	r := ExpressRouteCircuitPeeringDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.microsoftPeering(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("peering_type").HasValue("MicrosoftPeering"),
+				check.That(data.ResourceName).Key("vlan_id").HasValue("300"),
+				check.That(data.ResourceName).Key("peer_asn").HasValue("100"),
+				check.That(data.ResourceName).Key("primary_peer_address_prefix").HasValue("192.168.1.0/30"),
+				check.That(data.ResourceName).Key("secondary_peer_address_prefix").HasValue("192.168.2.0/30"),
+				check.That(data.ResourceName).Key("microsoft_peering_config.#").HasValue("1"),
+				check.That(data.ResourceName).Key("microsoft_peering_config.0.advertised_public_prefixes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("microsoft_peering_config.0.advertised_public_prefixes.0").HasValue("123.1.0.0/24"),
+			),
+		},
+	})
+}
+
+func (ExpressRouteCircuitPeeringDataSource) privatePeering(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_express_route_circuit_peering" "test" {
+  peering_type               = azurerm_express_route_circuit_peering.test.peering_type
+  express_route_circuit_name = azurerm_express_route_circuit_peering.test.express_route_circuit_name
+  resource_group_name        = azurerm_express_route_circuit_peering.test.resource_group_name
+}
+`, ExpressRouteCircuitPeeringResource{}.privatePeering(data))
+}
+
+func (ExpressRouteCircuitPeeringDataSource) microsoftPeering(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_express_route_circuit_peering" "test" {
+  peering_type               = azurerm_express_route_circuit_peering.test.peering_type
+  express_route_circuit_name = azurerm_express_route_circuit_peering.test.express_route_circuit_name
+  resource_group_name        = azurerm_express_route_circuit_peering.test.resource_group_name
+}
+`, ExpressRouteCircuitPeeringResource{}.msPeering(data))
+}
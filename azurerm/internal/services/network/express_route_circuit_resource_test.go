@@ -38,6 +38,7 @@ func TestAccExpressRouteCircuit(t *testing.T) {
 			"azurePrivatePeering":           testAccExpressRouteCircuitPeering_azurePrivatePeering,
 			"azurePrivatePeeringWithUpdate": testAccExpressRouteCircuitPeering_azurePrivatePeeringWithCircuitUpdate,
 			"requiresImport":                testAccExpressRouteCircuitPeering_requiresImport,
+			"data_basic":                    testAccDataSourceExpressRouteCircuitPeering_privatePeering,
 		},
 		"MicrosoftPeering": {
 			"microsoftPeering":                    testAccExpressRouteCircuitPeering_microsoftPeering,
@@ -46,6 +47,7 @@ func TestAccExpressRouteCircuit(t *testing.T) {
 			"microsoftPeeringIpv6":                testAccExpressRouteCircuitPeering_microsoftPeeringIpv6,
 			"microsoftPeeringIpv6CustomerRouting": testAccExpressRouteCircuitPeering_microsoftPeeringIpv6CustomerRouting,
 			"microsoftPeeringIpv6WithRouteFilter": testAccExpressRouteCircuitPeering_microsoftPeeringIpv6WithRouteFilter,
+			"data_microsoftPeering":               testAccDataSourceExpressRouteCircuitPeering_microsoftPeering,
 		},
 		"authorization": {
 			"basic":          testAccExpressRouteCircuitAuthorization_basic,
@@ -0,0 +1,230 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceIpGroupCidr() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpGroupCidrCreate,
+		Read:   resourceIpGroupCidrRead,
+		Delete: resourceIpGroupCidrDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.IpGroupCidrID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.IpGroupID,
+			},
+
+			"cidr": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					validation.IsCIDR,
+					validation.IsIPv4Address,
+				),
+			},
+		},
+	}
+}
+
+func resourceIpGroupCidrCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.IPGroupsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	ipGroupId, err := parse.IpGroupID(d.Get("ip_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	cidr := d.Get("cidr").(string)
+	// the CIDR contains a `/` which isn't valid within a Resource ID segment
+	id := parse.NewIpGroupCidrID(ipGroupId.SubscriptionId, ipGroupId.ResourceGroup, ipGroupId.Name, strings.ReplaceAll(cidr, "/", "_"))
+
+	locks.ByName(ipGroupId.Name, ipGroupResourceName)
+	defer locks.UnlockByName(ipGroupId.Name, ipGroupResourceName)
+
+	ipGroup, err := client.Get(ctx, ipGroupId.ResourceGroup, ipGroupId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(ipGroup.Response) {
+			return fmt.Errorf("%s was not found", *ipGroupId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *ipGroupId, err)
+	}
+	if ipGroupContainsCidr(ipGroup, cidr) {
+		return tf.ImportAsExistsError("azurerm_ip_group_cidr", id.ID())
+	}
+
+	if err := updateIpGroupCidr(ctx, client, *ipGroupId, cidr, true); err != nil {
+		return fmt.Errorf("adding CIDR %q to %s: %+v", cidr, *ipGroupId, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceIpGroupCidrRead(d, meta)
+}
+
+func resourceIpGroupCidrRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.IPGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IpGroupCidrID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ipGroupId := parse.NewIpGroupID(id.SubscriptionId, id.ResourceGroup, id.IpGroupName)
+	cidr := strings.ReplaceAll(id.CidrName, "_", "/")
+
+	ipGroup, err := client.Get(ctx, ipGroupId.ResourceGroup, ipGroupId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(ipGroup.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", ipGroupId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", ipGroupId, err)
+	}
+
+	if !ipGroupContainsCidr(ipGroup, cidr) {
+		log.Printf("[DEBUG] CIDR %q was not found in %s - removing from state!", cidr, ipGroupId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("ip_group_id", ipGroupId.ID())
+	d.Set("cidr", cidr)
+
+	return nil
+}
+
+func resourceIpGroupCidrDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.IPGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IpGroupCidrID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ipGroupId := parse.NewIpGroupID(id.SubscriptionId, id.ResourceGroup, id.IpGroupName)
+	cidr := strings.ReplaceAll(id.CidrName, "_", "/")
+
+	locks.ByName(ipGroupId.Name, ipGroupResourceName)
+	defer locks.UnlockByName(ipGroupId.Name, ipGroupResourceName)
+
+	if err := updateIpGroupCidr(ctx, client, ipGroupId, cidr, false); err != nil {
+		return fmt.Errorf("removing CIDR %q from %s: %+v", cidr, ipGroupId, err)
+	}
+
+	return nil
+}
+
+// updateIpGroupCidr adds or removes a single CIDR from an IP Group.
+//
+// The IP Group API doesn't support optimistic concurrency (the SDK clears the ETag when updating), therefore a
+// concurrent update from another process (for example a separate Terraform run) can silently overwrite this change.
+// To account for this, once the update has completed the IP Group is re-read to confirm the change was persisted -
+// and if not the update is retried until it is, or the timeout is reached.
+func updateIpGroupCidr(ctx context.Context, client *network.IPGroupsClient, id parse.IpGroupId, cidr string, add bool) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context is missing a timeout")
+	}
+
+	return resource.Retry(time.Until(deadline), func() *resource.RetryError {
+		ipGroup, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if !add && utils.ResponseWasNotFound(ipGroup.Response) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("retrieving %s: %+v", id, err))
+		}
+		if ipGroup.IPGroupPropertiesFormat == nil {
+			return resource.NonRetryableError(fmt.Errorf("retrieving %s: `properties` was nil", id))
+		}
+
+		if ipGroupContainsCidr(ipGroup, cidr) != add {
+			ipAddresses := make([]string, 0)
+			if existing := ipGroup.IPGroupPropertiesFormat.IPAddresses; existing != nil {
+				for _, ipAddress := range *existing {
+					if ipAddress != cidr {
+						ipAddresses = append(ipAddresses, ipAddress)
+					}
+				}
+			}
+			if add {
+				ipAddresses = append(ipAddresses, cidr)
+			}
+			ipGroup.IPGroupPropertiesFormat.IPAddresses = &ipAddresses
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, ipGroup)
+			if err != nil {
+				return resource.NonRetryableError(fmt.Errorf("updating %s: %+v", id, err))
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return resource.NonRetryableError(fmt.Errorf("waiting for update of %s: %+v", id, err))
+			}
+		}
+
+		updated, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if !add && utils.ResponseWasNotFound(updated.Response) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("retrieving %s: %+v", id, err))
+		}
+		if ipGroupContainsCidr(updated, cidr) != add {
+			log.Printf("[DEBUG] CIDR %q was overwritten by a concurrent update to %s - retrying..", cidr, id)
+			return resource.RetryableError(fmt.Errorf("the change to CIDR %q was overwritten by a concurrent update to %s", cidr, id))
+		}
+
+		return nil
+	})
+}
+
+func ipGroupContainsCidr(ipGroup network.IPGroup, cidr string) bool {
+	if props := ipGroup.IPGroupPropertiesFormat; props != nil && props.IPAddresses != nil {
+		for _, ipAddress := range *props.IPAddresses {
+			if ipAddress == cidr {
+				return true
+			}
+		}
+	}
+
+	return false
+}
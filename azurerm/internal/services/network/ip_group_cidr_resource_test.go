@@ -0,0 +1,209 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type IPGroupCidrResource struct {
+}
+
+func TestAccIpGroupCidr_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ip_group_cidr", "test")
+	r := IPGroupCidrResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIpGroupCidr_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ip_group_cidr", "test")
+	r := IPGroupCidrResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccIpGroupCidr_invalidCidr(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ip_group_cidr", "test")
+	r := IPGroupCidrResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.invalidCidr(data),
+			ExpectError: regexp.MustCompile("expected \"cidr\" to be a valid"),
+		},
+	})
+}
+
+func TestAccIpGroupCidr_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ip_group_cidr", "test")
+	r := IPGroupCidrResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_ip_group_cidr.second").ExistsInAzure(r),
+				check.That("azurerm_ip_group_cidr.third").ExistsInAzure(r),
+				data.CheckWithClientForResource(r.ipGroupHasCidrs("10.10.0.0/24", "10.20.0.0/24", "10.30.0.1"), "azurerm_ip_group.test"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// removing some of the CIDRs shouldn't affect the others
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClientForResource(r.ipGroupHasCidrs("10.10.0.0/24"), "azurerm_ip_group.test"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (IPGroupCidrResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.IpGroupCidrID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.IPGroupsClient.Get(ctx, id.ResourceGroup, id.IpGroupName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	cidr := strings.ReplaceAll(id.CidrName, "_", "/")
+	if props := resp.IPGroupPropertiesFormat; props != nil && props.IPAddresses != nil {
+		for _, ipAddress := range *props.IPAddresses {
+			if ipAddress == cidr {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+// ipGroupHasCidrs checks that the IP Group contains exactly the specified CIDRs
+func (IPGroupCidrResource) ipGroupHasCidrs(expected ...string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
+		id, err := parse.IpGroupID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := clients.Network.IPGroupsClient.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		actual := make([]string, 0)
+		if props := resp.IPGroupPropertiesFormat; props != nil && props.IPAddresses != nil {
+			actual = append(actual, *props.IPAddresses...)
+		}
+
+		sort.Strings(actual)
+		sort.Strings(expected)
+		if strings.Join(actual, ",") != strings.Join(expected, ",") {
+			return fmt.Errorf("expected %s to contain the CIDRs %v but got %v", *id, expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func (r IPGroupCidrResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ip_group_cidr" "test" {
+  ip_group_id = azurerm_ip_group.test.id
+  cidr        = "10.10.0.0/24"
+}
+`, r.template(data))
+}
+
+func (r IPGroupCidrResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ip_group_cidr" "import" {
+  ip_group_id = azurerm_ip_group_cidr.test.ip_group_id
+  cidr        = azurerm_ip_group_cidr.test.cidr
+}
+`, r.basic(data))
+}
+
+func (r IPGroupCidrResource) invalidCidr(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ip_group_cidr" "test" {
+  ip_group_id = azurerm_ip_group.test.id
+  cidr        = "10.10.0.0/33"
+}
+`, r.template(data))
+}
+
+func (r IPGroupCidrResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ip_group_cidr" "second" {
+  ip_group_id = azurerm_ip_group.test.id
+  cidr        = "10.20.0.0/24"
+}
+
+resource "azurerm_ip_group_cidr" "third" {
+  ip_group_id = azurerm_ip_group.test.id
+  cidr        = "10.30.0.1"
+}
+`, r.basic(data))
+}
+
+func (IPGroupCidrResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-network-%d"
+  location = "%s"
+}
+
+resource "azurerm_ip_group" "test" {
+  name                = "acceptanceTestIpGroup%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  lifecycle {
+    ignore_changes = [cidrs]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -13,11 +13,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var ipGroupResourceName = "azurerm_ip_group"
+
 func resourceIpGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIpGroupCreateUpdate,
@@ -84,6 +87,9 @@ func resourceIpGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	locks.ByName(name, ipGroupResourceName)
+	defer locks.UnlockByName(name, ipGroupResourceName)
+
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 	ipAddresses := d.Get("cidrs").(*schema.Set).List()
@@ -166,6 +172,9 @@ func resourceIpGroupDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	locks.ByName(id.Name, ipGroupResourceName)
+	defer locks.UnlockByName(id.Name, ipGroupResourceName)
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting IP Group %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
@@ -41,6 +41,11 @@ func dataSourceVirtualNetwork() *schema.Resource {
 				},
 			},
 
+			"bgp_community": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"dns_servers": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -104,6 +109,12 @@ func dataSourceVnetRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
+		bgpCommunity := ""
+		if p := props.BgpCommunities; p != nil && p.VirtualNetworkCommunity != nil {
+			bgpCommunity = *p.VirtualNetworkCommunity
+		}
+		d.Set("bgp_community", bgpCommunity)
+
 		if options := props.DhcpOptions; options != nil {
 			if err := d.Set("dns_servers", utils.FlattenStringSlice(options.DNSServers)); err != nil {
 				return fmt.Errorf("error setting `dns_servers`: %v", err)
@@ -28,6 +28,7 @@ func TestAccDataSourceVirtualNetwork_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("dns_servers.0").HasValue("10.0.0.4"),
 				check.That(data.ResourceName).Key("address_space.0").HasValue("10.0.0.0/16"),
 				check.That(data.ResourceName).Key("subnets.0").HasValue("subnet1"),
+				check.That(data.ResourceName).Key("bgp_community").HasValue("12076:20000"),
 			),
 		},
 	})
@@ -76,6 +77,8 @@ resource "azurerm_virtual_network" "test" {
     name           = "subnet1"
     address_prefix = "10.0.1.0/24"
   }
+
+  bgp_community = "12076:20000"
 }
 
 data "azurerm_virtual_network" "test" {
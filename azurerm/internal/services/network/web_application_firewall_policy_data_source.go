@@ -32,6 +32,170 @@ func dataWebApplicationFirewallPolicy() *schema.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
 
+			"custom_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"match_conditions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_values": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"match_variables": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"variable_name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"selector": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"operator": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"negation_condition": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"transforms": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rule_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"managed_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exclusion": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_variable": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"selector": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"selector_match_operator": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"managed_rule_set": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"rule_group_override": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"rule_group_name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"disabled_rules": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"policy_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_body_check": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"file_upload_limit_in_mb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_request_body_size_in_kb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -60,5 +224,17 @@ func dataSourceWebApplicationFirewallPolicy(d *schema.ResourceData, meta interfa
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
+	if props := resp.WebApplicationFirewallPolicyPropertiesFormat; props != nil {
+		if err := d.Set("custom_rules", flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(props.CustomRules)); err != nil {
+			return fmt.Errorf("setting `custom_rules`: %+v", err)
+		}
+		if err := d.Set("policy_settings", flattenWebApplicationFirewallPolicyPolicySettings(props.PolicySettings)); err != nil {
+			return fmt.Errorf("setting `policy_settings`: %+v", err)
+		}
+		if err := d.Set("managed_rules", flattenWebApplicationFirewallPolicyManagedRulesDefinition(props.ManagedRules)); err != nil {
+			return fmt.Errorf("setting `managed_rules`: %+v", err)
+		}
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
@@ -25,6 +25,18 @@ func TestAccDataSourceAzureRMWebApplicationFirewallPolicy_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("resource_group_name").HasValue(resourceGroupName),
 				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
 				check.That(data.ResourceName).Key("tags.env").HasValue("test"),
+				check.That(data.ResourceName).Key("custom_rules.#").HasValue("2"),
+				check.That(data.ResourceName).Key("custom_rules.0.name").HasValue("Rule1"),
+				check.That(data.ResourceName).Key("custom_rules.0.action").HasValue("Block"),
+				check.That(data.ResourceName).Key("custom_rules.1.name").HasValue("Rule2"),
+				check.That(data.ResourceName).Key("managed_rules.#").HasValue("1"),
+				check.That(data.ResourceName).Key("managed_rules.0.exclusion.#").HasValue("2"),
+				check.That(data.ResourceName).Key("managed_rules.0.managed_rule_set.0.type").HasValue("OWASP"),
+				check.That(data.ResourceName).Key("managed_rules.0.managed_rule_set.0.version").HasValue("3.1"),
+				check.That(data.ResourceName).Key("managed_rules.0.managed_rule_set.0.rule_group_override.0.disabled_rules.#").HasValue("2"),
+				check.That(data.ResourceName).Key("policy_settings.#").HasValue("1"),
+				check.That(data.ResourceName).Key("policy_settings.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("policy_settings.0.mode").HasValue("Prevention"),
 			),
 		},
 	})
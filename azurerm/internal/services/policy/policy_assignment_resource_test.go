@@ -126,9 +126,26 @@ func TestAccAzureRMPolicyAssignment_enforcement_mode(t *testing.T) {
 
 	data.ResourceTest(t, r, []resource.TestStep{
 		{
-			Config: r.enforcementMode(data),
+			Config: r.enforcementMode(data, false),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enforcement_mode").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.enforcementMode(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enforcement_mode").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.enforcementMode(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enforcement_mode").HasValue("false"),
 			),
 		},
 		data.ImportStep(),
@@ -485,7 +502,7 @@ PARAMETERS
 `, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.Locations.Primary)
 }
 
-func (r PolicyAssignmentResource) enforcementMode(data acceptance.TestData) string {
+func (r PolicyAssignmentResource) enforcementMode(data acceptance.TestData, enforced bool) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
@@ -540,7 +557,7 @@ resource "azurerm_policy_assignment" "test" {
   scope                = data.azurerm_subscription.current.id
   policy_definition_id = azurerm_policy_definition.test.id
   description          = "Policy Assignment created via an Acceptance Test"
-  enforcement_mode     = false
+  enforcement_mode     = %t
   display_name         = "Acceptance Test Run %d"
 
   parameters = <<PARAMETERS
@@ -552,5 +569,5 @@ resource "azurerm_policy_assignment" "test" {
 PARAMETERS
 
 }
-`, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.Locations.Primary)
+`, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.Locations.Primary, data.RandomInteger, enforced, data.RandomInteger, data.Locations.Primary)
 }
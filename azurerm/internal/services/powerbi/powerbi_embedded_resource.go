@@ -67,6 +67,7 @@ func resourcePowerBIEmbedded() *schema.Resource {
 			"administrators": {
 				Type:     schema.TypeSet,
 				Required: true,
+				MinItems: 1,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validate.EmbeddedAdministratorName,
@@ -3,6 +3,7 @@ package powerbi_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -67,6 +68,18 @@ func TestAccPowerBIEmbedded_complete(t *testing.T) {
 	})
 }
 
+func TestAccPowerBIEmbedded_noAdministrators(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_powerbi_embedded", "test")
+	r := PowerBIEmbeddedResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.noAdministrators(data),
+			ExpectError: regexp.MustCompile("attribute supports 1 item as a minimum"),
+		},
+	})
+}
+
 func TestAccPowerBIEmbedded_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_powerbi_embedded", "test")
 	r := PowerBIEmbeddedResource{}
@@ -128,6 +141,21 @@ resource "azurerm_powerbi_embedded" "test" {
 `, template, data.RandomInteger)
 }
 
+func (PowerBIEmbeddedResource) noAdministrators(data acceptance.TestData) string {
+	template := PowerBIEmbeddedResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_powerbi_embedded" "test" {
+  name                = "acctestpowerbi%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku_name            = "A1"
+  administrators      = []
+}
+`, template, data.RandomInteger)
+}
+
 func (r PowerBIEmbeddedResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
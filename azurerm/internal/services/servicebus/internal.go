@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -111,3 +113,23 @@ func authorizationRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff,
 
 	return nil
 }
+
+// suppressForwardToEntityNameDiff suppresses the diff between the name of a Queue/Topic to forward to and the
+// value returned by the API, which can differ in casing or either side can be the fully qualified entity URL
+func suppressForwardToEntityNameDiff(_, old, new string, _ *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	return strings.EqualFold(forwardToEntityName(old), forwardToEntityName(new))
+}
+
+// forwardToEntityName returns the name of the Queue/Topic to forward to, stripping the scheme and host when
+// the value is the fully qualified entity URL (e.g. `sb://{namespace}.servicebus.windows.net/{name}`)
+func forwardToEntityName(input string) string {
+	if u, err := url.Parse(input); err == nil && u.Scheme != "" && u.Host != "" {
+		return strings.Trim(u.Path, "/")
+	}
+
+	return input
+}
@@ -0,0 +1,153 @@
+package servicebus
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigFailover() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceBusNamespaceDisasterRecoveryConfigFailoverCreate,
+		Read:   resourceServiceBusNamespaceDisasterRecoveryConfigFailoverRead,
+		Delete: resourceServiceBusNamespaceDisasterRecoveryConfigFailoverDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NamespaceDisasterRecoveryConfigID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"disaster_recovery_config_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NamespaceDisasterRecoveryConfigID,
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigFailoverCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NamespaceDisasterRecoveryConfigID(d.Get("disaster_recovery_config_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.NamespaceName, serviceBusNamespaceResourceName)
+	defer locks.UnlockByName(id.NamespaceName, serviceBusNamespaceResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.ArmDisasterRecoveryProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	// a failover can only be initiated from the Secondary Namespace, which then becomes the Primary
+	if role := existing.ArmDisasterRecoveryProperties.Role; role != servicebus.Secondary {
+		return fmt.Errorf("a failover can only be initiated for a Disaster Recovery Config with the role %q but %s has the role %q", string(servicebus.Secondary), *id, string(role))
+	}
+
+	if _, err := client.FailOver(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName); err != nil {
+		return fmt.Errorf("failing over %s: %+v", *id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{string(servicebus.Secondary)},
+		Target:     []string{string(servicebus.Primary), string(servicebus.PrimaryNotReplicating)},
+		MinTimeout: 30 * time.Second,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+			if err != nil {
+				return nil, "error", fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			props := resp.ArmDisasterRecoveryProperties
+			if props == nil {
+				return resp, "nil", fmt.Errorf("waiting for the failover of %s: `properties` was nil", *id)
+			}
+			if props.ProvisioningState == servicebus.Failed {
+				return resp, "failed", fmt.Errorf("the failover of %s failed", *id)
+			}
+			if props.ProvisioningState != servicebus.Succeeded {
+				return resp, string(servicebus.Secondary), nil
+			}
+
+			return resp, string(props.Role), nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for the failover of %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceServiceBusNamespaceDisasterRecoveryConfigFailoverRead(d, meta)
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigFailoverRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NamespaceDisasterRecoveryConfigID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("disaster_recovery_config_id", id.ID())
+
+	if props := resp.ArmDisasterRecoveryProperties; props != nil {
+		d.Set("role", string(props.Role))
+	}
+
+	return nil
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigFailoverDelete(_ *schema.ResourceData, _ interface{}) error {
+	// a failover can't be undone, so there's nothing to delete
+	return nil
+}
@@ -0,0 +1,66 @@
+package servicebus_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ServiceBusNamespaceDisasterRecoveryConfigFailoverResource struct {
+}
+
+func TestAccServiceBusNamespaceDisasterRecoveryConfigFailover_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_disaster_recovery_config_failover", "test")
+	r := ServiceBusNamespaceDisasterRecoveryConfigFailoverResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: ServiceBusNamespaceDisasterRecoveryConfigResource{}.basic(data),
+		},
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("PrimaryNotReplicating"),
+			),
+			// once failed over the pairing is broken, so the Disaster Recovery Config on the
+			// original Primary Namespace no longer matches the configuration
+			ExpectNonEmptyPlan: true,
+		},
+	})
+}
+
+func (ServiceBusNamespaceDisasterRecoveryConfigFailoverResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.NamespaceDisasterRecoveryConfigID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceBus.DisasterRecoveryConfigsClient.Get(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ArmDisasterRecoveryProperties != nil), nil
+}
+
+func (ServiceBusNamespaceDisasterRecoveryConfigFailoverResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config_failover" "test" {
+  disaster_recovery_config_id = "${azurerm_servicebus_namespace.secondary.id}/disasterRecoveryConfigs/${azurerm_servicebus_namespace_disaster_recovery_config.test.name}"
+}
+`, ServiceBusNamespaceDisasterRecoveryConfigResource{}.basic(data))
+}
@@ -0,0 +1,296 @@
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceServiceBusNamespaceDisasterRecoveryConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceBusNamespaceDisasterRecoveryConfigCreate,
+		Read:   resourceServiceBusNamespaceDisasterRecoveryConfigRead,
+		Update: resourceServiceBusNamespaceDisasterRecoveryConfigUpdate,
+		Delete: resourceServiceBusNamespaceDisasterRecoveryConfigDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NamespaceDisasterRecoveryConfigID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NamespaceName,
+			},
+
+			"namespace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NamespaceID,
+			},
+
+			"partner_namespace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NamespaceID,
+			},
+
+			"alias_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"provisioning_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	namespaceId, err := parse.NamespaceID(d.Get("namespace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNamespaceDisasterRecoveryConfigID(namespaceId.SubscriptionId, namespaceId.ResourceGroup, namespaceId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_servicebus_namespace_disaster_recovery_config", id.ID())
+	}
+
+	locks.ByName(id.NamespaceName, serviceBusNamespaceResourceName)
+	defer locks.UnlockByName(id.NamespaceName, serviceBusNamespaceResourceName)
+
+	parameters := servicebus.ArmDisasterRecovery{
+		ArmDisasterRecoveryProperties: &servicebus.ArmDisasterRecoveryProperties{
+			PartnerNamespace: utils.String(d.Get("partner_namespace_id").(string)),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, id, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("waiting for replication to complete for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceServiceBusNamespaceDisasterRecoveryConfigRead(d, meta)
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NamespaceDisasterRecoveryConfigID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.NamespaceName, serviceBusNamespaceResourceName)
+	defer locks.UnlockByName(id.NamespaceName, serviceBusNamespaceResourceName)
+
+	if d.HasChange("partner_namespace_id") {
+		// the pairing has to be broken before the namespace can be paired with a different partner
+		if _, err := client.BreakPairing(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName); err != nil {
+			return fmt.Errorf("breaking the pairing for %s: %+v", *id, err)
+		}
+
+		if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("waiting for the pairing to be broken for %s: %+v", *id, err)
+		}
+	}
+
+	parameters := servicebus.ArmDisasterRecovery{
+		ArmDisasterRecoveryProperties: &servicebus.ArmDisasterRecoveryProperties{
+			PartnerNamespace: utils.String(d.Get("partner_namespace_id").(string)),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("waiting for replication to complete for %s: %+v", *id, err)
+	}
+
+	return resourceServiceBusNamespaceDisasterRecoveryConfigRead(d, meta)
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NamespaceDisasterRecoveryConfigID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DisasterRecoveryConfigName)
+	d.Set("alias_name", resp.Name)
+	d.Set("namespace_id", parse.NewNamespaceID(id.SubscriptionId, id.ResourceGroup, id.NamespaceName).ID())
+
+	if props := resp.ArmDisasterRecoveryProperties; props != nil {
+		d.Set("partner_namespace_id", props.PartnerNamespace)
+		d.Set("role", string(props.Role))
+		d.Set("provisioning_state", string(props.ProvisioningState))
+	}
+
+	return nil
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NamespaceDisasterRecoveryConfigID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.NamespaceName, serviceBusNamespaceResourceName)
+	defer locks.UnlockByName(id.NamespaceName, serviceBusNamespaceResourceName)
+
+	if _, err := client.BreakPairing(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName); err != nil {
+		return fmt.Errorf("breaking the pairing for %s: %+v", *id, err)
+	}
+
+	if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("waiting for the pairing to be broken for %s: %+v", *id, err)
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	// there's no future for the deletion, so poll until it's gone
+	deleteWait := &resource.StateChangeConf{
+		Pending:    []string{"200"},
+		Target:     []string{"404"},
+		MinTimeout: 30 * time.Second,
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, strconv.Itoa(resp.StatusCode), nil
+				}
+				return nil, "nil", fmt.Errorf("polling for the deletion of %s: %+v", *id, err)
+			}
+
+			return resp, strconv.Itoa(resp.StatusCode), nil
+		},
+	}
+
+	if _, err := deleteWait.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	// it can take some time for the alias name to become available again
+	nameFreeWait := &resource.StateChangeConf{
+		Pending:    []string{"NameInUse"},
+		Target:     []string{"None"},
+		MinTimeout: 30 * time.Second,
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.CheckNameAvailabilityMethod(ctx, id.ResourceGroup, id.NamespaceName, servicebus.CheckNameAvailability{Name: utils.String(id.DisasterRecoveryConfigName)})
+			if err != nil {
+				return resp, "Error", fmt.Errorf("checking if the name for %s has been freed: %+v", *id, err)
+			}
+
+			return resp, string(resp.Reason), nil
+		},
+	}
+
+	if _, err := nameFreeWait.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for the name of %s to become available: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx context.Context, client *servicebus.DisasterRecoveryConfigsClient, id parse.NamespaceDisasterRecoveryConfigId, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{string(servicebus.Accepted)},
+		Target:     []string{string(servicebus.Succeeded)},
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+		Refresh: func() (interface{}, string, error) {
+			read, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+			if err != nil {
+				return nil, "error", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if props := read.ArmDisasterRecoveryProperties; props != nil {
+				if props.ProvisioningState == servicebus.Failed {
+					return read, "failed", fmt.Errorf("replication for %s failed", id)
+				}
+				return read, string(props.ProvisioningState), nil
+			}
+
+			return read, "nil", fmt.Errorf("waiting for replication of %s: `properties` was nil", id)
+		},
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
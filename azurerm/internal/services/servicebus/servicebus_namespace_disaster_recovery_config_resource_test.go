@@ -0,0 +1,180 @@
+package servicebus_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ServiceBusNamespaceDisasterRecoveryConfigResource struct {
+}
+
+func TestAccServiceBusNamespaceDisasterRecoveryConfig_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_disaster_recovery_config", "test")
+	r := ServiceBusNamespaceDisasterRecoveryConfigResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("alias_name").HasValue(fmt.Sprintf("acctest-sb-drc-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+				check.That(data.ResourceName).Key("provisioning_state").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceBusNamespaceDisasterRecoveryConfig_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_disaster_recovery_config", "test")
+	r := ServiceBusNamespaceDisasterRecoveryConfigResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccServiceBusNamespaceDisasterRecoveryConfig_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_disaster_recovery_config", "test")
+	r := ServiceBusNamespaceDisasterRecoveryConfigResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.removed(data),
+		},
+	})
+}
+
+func (ServiceBusNamespaceDisasterRecoveryConfigResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.NamespaceDisasterRecoveryConfigID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceBus.DisasterRecoveryConfigsClient.Get(ctx, id.ResourceGroup, id.NamespaceName, id.DisasterRecoveryConfigName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ArmDisasterRecoveryProperties != nil), nil
+}
+
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "test" {
+  name                 = "acctest-sb-drc-%d"
+  namespace_id         = azurerm_servicebus_namespace.primary.id
+  partner_namespace_id = azurerm_servicebus_namespace.secondary.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "import" {
+  name                 = azurerm_servicebus_namespace_disaster_recovery_config.test.name
+  namespace_id         = azurerm_servicebus_namespace_disaster_recovery_config.test.namespace_id
+  partner_namespace_id = azurerm_servicebus_namespace_disaster_recovery_config.test.partner_namespace_id
+}
+`, r.basic(data))
+}
+
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_servicebus_namespace" "other" {
+  name                = "acctest-sb-%[2]d-c"
+  location            = "%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Premium"
+  capacity            = 1
+}
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "test" {
+  name                 = "acctest-sb-drc-%[2]d"
+  namespace_id         = azurerm_servicebus_namespace.primary.id
+  partner_namespace_id = azurerm_servicebus_namespace.other.id
+}
+`, r.template(data), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) removed(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_servicebus_namespace" "other" {
+  name                = "acctest-sb-%[2]d-c"
+  location            = "%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Premium"
+  capacity            = 1
+}
+`, r.template(data), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (ServiceBusNamespaceDisasterRecoveryConfigResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-servicebus-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_servicebus_namespace" "primary" {
+  name                = "acctest-sb-%[1]d-a"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Premium"
+  capacity            = 1
+}
+
+resource "azurerm_servicebus_namespace" "secondary" {
+  name                = "acctest-sb-%[1]d-b"
+  location            = "%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Premium"
+  capacity            = 1
+}
+`, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
+}
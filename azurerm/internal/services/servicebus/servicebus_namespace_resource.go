@@ -29,6 +29,8 @@ import (
 // default connection strings and keys
 var serviceBusNamespaceDefaultAuthorizationRule = "RootManageSharedAccessKey"
 
+var serviceBusNamespaceResourceName = "azurerm_servicebus_namespace"
+
 func resourceServiceBusNamespace() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceServiceBusNamespaceCreateUpdate,
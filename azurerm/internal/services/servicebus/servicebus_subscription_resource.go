@@ -108,13 +108,17 @@ func resourceServiceBusSubscription() *schema.Resource {
 			},
 
 			"forward_to": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validate.ForwardToEntityName,
+				DiffSuppressFunc: suppressForwardToEntityNameDiff,
 			},
 
 			"forward_dead_lettered_messages_to": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validate.ForwardToEntityName,
+				DiffSuppressFunc: suppressForwardToEntityNameDiff,
 			},
 
 			"status": {
@@ -172,11 +176,11 @@ func resourceServiceBusSubscriptionCreateUpdate(d *schema.ResourceData, meta int
 	}
 
 	if forwardTo := d.Get("forward_to").(string); forwardTo != "" {
-		parameters.SBSubscriptionProperties.ForwardTo = &forwardTo
+		parameters.SBSubscriptionProperties.ForwardTo = utils.String(forwardToEntityName(forwardTo))
 	}
 
 	if forwardDeadLetteredMessagesTo := d.Get("forward_dead_lettered_messages_to").(string); forwardDeadLetteredMessagesTo != "" {
-		parameters.SBSubscriptionProperties.ForwardDeadLetteredMessagesTo = &forwardDeadLetteredMessagesTo
+		parameters.SBSubscriptionProperties.ForwardDeadLetteredMessagesTo = utils.String(forwardToEntityName(forwardDeadLetteredMessagesTo))
 	}
 
 	if defaultMessageTtl := d.Get("default_message_ttl").(string); defaultMessageTtl != "" {
@@ -152,6 +152,43 @@ func TestAccServiceBusSubscription_updateForwardDeadLetteredMessagesTo(t *testin
 	})
 }
 
+func TestAccServiceBusSubscription_forwardChain(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_subscription", "test")
+	r := ServiceBusSubscriptionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.forwardChain(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("forward_to").HasValue(fmt.Sprintf("acctestservicebustopic-forward_to-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("forward_dead_lettered_messages_to").HasValue(fmt.Sprintf("acctestservicebusqueue-deadletter-%d", data.RandomInteger)),
+				check.That("azurerm_servicebus_subscription.forward_to").ExistsInAzure(r),
+				check.That("azurerm_servicebus_subscription.forward_to").Key("forward_to").HasValue(fmt.Sprintf("acctestservicebusqueue-forward_to-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+		data.ImportStepFor("azurerm_servicebus_subscription.forward_to"),
+	})
+}
+
+func TestAccServiceBusSubscription_forwardToUrl(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_subscription", "test")
+	r := ServiceBusSubscriptionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.forwardToUrl(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("forward_to").HasValue(fmt.Sprintf("acctestservicebustopic-forward_to-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("forward_dead_lettered_messages_to").HasValue(fmt.Sprintf("acctestservicebusqueue-deadletter-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccServiceBusSubscription_updateDeadLetteringOnFilterEvaluationExceptions(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_servicebus_subscription", "test")
 	r := ServiceBusSubscriptionResource{}
@@ -314,6 +351,59 @@ resource "azurerm_servicebus_topic" "forward_dl_messages_to" {
 		"forward_dead_lettered_messages_to = \"${azurerm_servicebus_topic.forward_dl_messages_to.name}\"\n", data.RandomInteger)
 }
 
+func (ServiceBusSubscriptionResource) forwardChain(data acceptance.TestData) string {
+	forwardChainTf := testAccServiceBusSubscription_tfTemplate + `
+resource "azurerm_servicebus_topic" "forward_to" {
+  name                = "acctestservicebustopic-forward_to-%d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_servicebus_subscription" "forward_to" {
+  name                = "acctestsbsub-fwd-%d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  topic_name          = azurerm_servicebus_topic.forward_to.name
+  resource_group_name = azurerm_resource_group.test.name
+  max_delivery_count  = 10
+  forward_to          = azurerm_servicebus_queue.forward_to.name
+}
+
+resource "azurerm_servicebus_queue" "forward_to" {
+  name                = "acctestservicebusqueue-forward_to-%d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_servicebus_queue" "deadletter" {
+  name                = "acctestservicebusqueue-deadletter-%d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+`
+	return fmt.Sprintf(forwardChainTf, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger,
+		"forward_to                        = azurerm_servicebus_topic.forward_to.name\n  forward_dead_lettered_messages_to = azurerm_servicebus_queue.deadletter.name\n",
+		data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (ServiceBusSubscriptionResource) forwardToUrl(data acceptance.TestData) string {
+	forwardToUrlTf := testAccServiceBusSubscription_tfTemplate + `
+resource "azurerm_servicebus_topic" "forward_to" {
+  name                = "acctestservicebustopic-forward_to-%d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_servicebus_queue" "deadletter" {
+  name                = "acctestservicebusqueue-deadletter-%d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+`
+	return fmt.Sprintf(forwardToUrlTf, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger,
+		"forward_to                        = \"sb://${azurerm_servicebus_namespace.test.name}.servicebus.windows.net/${azurerm_servicebus_topic.forward_to.name}\"\n  forward_dead_lettered_messages_to = \"https://${azurerm_servicebus_namespace.test.name}.servicebus.windows.net/${azurerm_servicebus_queue.deadletter.name}\"\n",
+		data.RandomInteger, data.RandomInteger)
+}
+
 func (ServiceBusSubscriptionResource) status(data acceptance.TestData, status string) string {
 	return fmt.Sprintf(testAccServiceBusSubscription_tfTemplate, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger,
 		fmt.Sprintf("status = \"%s\"", status))
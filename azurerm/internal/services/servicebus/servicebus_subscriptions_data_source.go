@@ -0,0 +1,179 @@
+package servicebus
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceServiceBusSubscriptions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceServiceBusSubscriptionsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"topic_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.TopicID,
+			},
+
+			"subscriptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"auto_delete_on_idle": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"default_message_ttl": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"lock_duration": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"dead_lettering_on_message_expiration": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"dead_lettering_on_filter_evaluation_error": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"enable_batched_operations": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"max_delivery_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"requires_session": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"forward_to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"forward_dead_lettered_messages_to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceBusSubscriptionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.SubscriptionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	topicId, err := parse.TopicID(d.Get("topic_id").(string))
+	if err != nil {
+		return err
+	}
+
+	subscriptions := make([]interface{}, 0)
+	iterator, err := client.ListByTopicComplete(ctx, topicId.ResourceGroup, topicId.NamespaceName, topicId.Name, nil, nil)
+	if err != nil {
+		return fmt.Errorf("listing Subscriptions for %s: %+v", *topicId, err)
+	}
+	for iterator.NotDone() {
+		subscriptions = append(subscriptions, flattenServiceBusSubscription(*topicId, iterator.Value()))
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Subscriptions for %s: %+v", *topicId, err)
+		}
+	}
+
+	d.SetId(topicId.ID())
+
+	d.Set("topic_id", topicId.ID())
+	if err := d.Set("subscriptions", subscriptions); err != nil {
+		return fmt.Errorf("setting `subscriptions`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenServiceBusSubscription(topicId parse.TopicId, input servicebus.SBSubscription) map[string]interface{} {
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	output := map[string]interface{}{
+		"id":   parse.NewSubscriptionID(topicId.SubscriptionId, topicId.ResourceGroup, topicId.NamespaceName, topicId.Name, name).ID(),
+		"name": name,
+	}
+
+	if props := input.SBSubscriptionProperties; props != nil {
+		if props.AutoDeleteOnIdle != nil {
+			output["auto_delete_on_idle"] = *props.AutoDeleteOnIdle
+		}
+		if props.DefaultMessageTimeToLive != nil {
+			output["default_message_ttl"] = *props.DefaultMessageTimeToLive
+		}
+		if props.LockDuration != nil {
+			output["lock_duration"] = *props.LockDuration
+		}
+		if props.DeadLetteringOnMessageExpiration != nil {
+			output["dead_lettering_on_message_expiration"] = *props.DeadLetteringOnMessageExpiration
+		}
+		if props.DeadLetteringOnFilterEvaluationExceptions != nil {
+			output["dead_lettering_on_filter_evaluation_error"] = *props.DeadLetteringOnFilterEvaluationExceptions
+		}
+		if props.EnableBatchedOperations != nil {
+			output["enable_batched_operations"] = *props.EnableBatchedOperations
+		}
+		if props.MaxDeliveryCount != nil {
+			output["max_delivery_count"] = int(*props.MaxDeliveryCount)
+		}
+		if props.RequiresSession != nil {
+			output["requires_session"] = *props.RequiresSession
+		}
+		if props.ForwardTo != nil {
+			output["forward_to"] = *props.ForwardTo
+		}
+		if props.ForwardDeadLetteredMessagesTo != nil {
+			output["forward_dead_lettered_messages_to"] = *props.ForwardDeadLetteredMessagesTo
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,84 @@
+package servicebus_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type ServiceBusSubscriptionsDataSource struct {
+}
+
+func TestAccDataSourceServiceBusSubscriptions_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_servicebus_subscriptions", "test")
+	r := ServiceBusSubscriptionsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("subscriptions.#").HasValue("2"),
+				check.That(data.ResourceName).Key("subscriptions.0.id").Exists(),
+				check.That(data.ResourceName).Key("subscriptions.0.name").Exists(),
+				check.That(data.ResourceName).Key("subscriptions.0.max_delivery_count").HasValue("10"),
+				check.That(data.ResourceName).Key("subscriptions.1.id").Exists(),
+				check.That(data.ResourceName).Key("subscriptions.1.name").Exists(),
+				check.That(data.ResourceName).Key("subscriptions.1.max_delivery_count").HasValue("10"),
+			),
+		},
+	})
+}
+
+func (ServiceBusSubscriptionsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_servicebus_topic" "test" {
+  name                = "acctestservicebustopic-%d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_servicebus_subscription" "first" {
+  name                = "acctestservicebussubscription-%d-1"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  topic_name          = azurerm_servicebus_topic.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  max_delivery_count  = 10
+}
+
+resource "azurerm_servicebus_subscription" "second" {
+  name                = "acctestservicebussubscription-%d-2"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  topic_name          = azurerm_servicebus_topic.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  max_delivery_count  = 10
+}
+
+data "azurerm_servicebus_subscriptions" "test" {
+  topic_id = azurerm_servicebus_topic.test.id
+
+  depends_on = [
+    azurerm_servicebus_subscription.first,
+    azurerm_servicebus_subscription.second,
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,29 @@
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// ForwardToEntityName validates the name of a Queue or Topic which messages can be forwarded to, which can
+// either be specified as the entity name or as the fully qualified entity URL (e.g. `sb://{namespace}.servicebus.windows.net/{name}`)
+func ForwardToEntityName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	name := v
+	if u, err := url.Parse(v); err == nil && u.Scheme != "" && u.Host != "" {
+		name = strings.Trim(u.Path, "/")
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9]([\w-./~]{0,258}[a-zA-Z0-9])?$`).MatchString(name) {
+		errors = append(errors, fmt.Errorf("%q must be the name or URL of a Queue or Topic. The name can contain only letters, numbers, periods, hyphens, tildas, forward slashes and underscores, must start and end with a letter or number and be less than 260 characters long, got %q", k, v))
+	}
+
+	return
+}
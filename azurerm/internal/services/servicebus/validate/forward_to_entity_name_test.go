@@ -0,0 +1,90 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestForwardToEntityName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{
+			name:  "Empty value",
+			input: "",
+			valid: false,
+		},
+		{
+			name:  "Queue name",
+			input: "queue1",
+			valid: true,
+		},
+		{
+			name:  "Topic name with periods, hyphens, tildas and underscores",
+			input: "topic.name-1~a_b",
+			valid: true,
+		},
+		{
+			name:  "Queue name with forward slash",
+			input: "path/queue1",
+			valid: true,
+		},
+		{
+			name:  "Invalid name starts with underscore",
+			input: "_queue1",
+			valid: false,
+		},
+		{
+			name:  "Invalid name ends with period",
+			input: "queue1.",
+			valid: false,
+		},
+		{
+			name:  "Invalid name with a space",
+			input: "queue 1",
+			valid: false,
+		},
+		{
+			name:  "Fully qualified Service Bus URL",
+			input: "sb://namespace1.servicebus.windows.net/queue1",
+			valid: true,
+		},
+		{
+			name:  "Fully qualified HTTPS URL with trailing slash",
+			input: "https://namespace1.servicebus.windows.net/topic1/",
+			valid: true,
+		},
+		{
+			name:  "Fully qualified URL without an entity name",
+			input: "sb://namespace1.servicebus.windows.net/",
+			valid: false,
+		},
+		{
+			name:  "Fully qualified URL with an invalid entity name",
+			input: "sb://namespace1.servicebus.windows.net/_queue1",
+			valid: false,
+		},
+		{
+			name:  "Name of 260 characters",
+			input: strings.Repeat("a", 260),
+			valid: true,
+		},
+		{
+			name:  "Invalid name of 261 characters",
+			input: strings.Repeat("a", 261),
+			valid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errors := ForwardToEntityName(tt.input, "forward_to")
+			valid := len(errors) == 0
+			if tt.valid != valid {
+				t.Fatalf("Expected %t but got %t for %q", tt.valid, valid, tt.input)
+			}
+		})
+	}
+}
@@ -202,7 +202,8 @@ func resourceSpringCloudAppUpdate(d *schema.ResourceData, meta interface{}) erro
 			PersistentDisk:    expandSpringCloudAppPersistentDisk(d.Get("persistent_disk").([]interface{})),
 		},
 	}
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SpringName, id.AppName, app)
+	// the PATCH API is used here so that toggling `is_public` updates the existing App in-place
+	future, err := client.Update(ctx, id.ResourceGroup, id.SpringName, id.AppName, app)
 	if err != nil {
 		return fmt.Errorf("update %s: %+v", id, err)
 	}
@@ -95,6 +95,38 @@ func TestAccSpringCloudApp_update(t *testing.T) {
 	})
 }
 
+func TestAccSpringCloudApp_updatePublic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_app", "test")
+	r := SpringCloudAppResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.public(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("url").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.public(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("url").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.public(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("url").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t SpringCloudAppResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := parse.SpringCloudAppID(state.ID)
 	if err != nil {
@@ -158,6 +190,19 @@ resource "azurerm_spring_cloud_app" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r SpringCloudAppResource) public(data acceptance.TestData, isPublic bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_spring_cloud_app" "test" {
+  name                = "acctest-sca-%d"
+  resource_group_name = azurerm_spring_cloud_service.test.resource_group_name
+  service_name        = azurerm_spring_cloud_service.test.name
+  is_public           = %t
+}
+`, r.template(data), data.RandomInteger, isPublic)
+}
+
 func (SpringCloudAppResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -3,6 +3,7 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
@@ -380,3 +381,44 @@ func convertBase64ToHexEncoding(str string) (string, error) {
 
 	return hex.EncodeToString(data), nil
 }
+
+// blobContentsGetter is the subset of the Blobs Client needed to download the contents of a Blob
+type blobContentsGetter interface {
+	Get(ctx context.Context, accountName, containerName, blobName string, input blobs.GetInput) (blobs.GetResult, error)
+}
+
+// blobContentMD5ChunkSize is the size of each ranged read used to download a Blob when verifying its Content MD5
+const blobContentMD5ChunkSize int64 = 4 * 1024 * 1024
+
+// verifyBlobContentMD5 downloads the contents of the Blob in chunks and confirms that the MD5 of the contents
+// matches the Base64 encoded MD5 stored against the Blob
+func verifyBlobContentMD5(ctx context.Context, client blobContentsGetter, accountName, containerName, blobName string, contentLength int64, expectedContentMD5 string) error {
+	hash := md5.New()
+	for startByte := int64(0); startByte < contentLength; startByte += blobContentMD5ChunkSize {
+		start := startByte
+		end := start + blobContentMD5ChunkSize - 1
+		if end >= contentLength {
+			end = contentLength - 1
+		}
+
+		result, err := client.Get(ctx, accountName, containerName, blobName, blobs.GetInput{
+			StartByte: &start,
+			EndByte:   &end,
+		})
+		if err != nil {
+			return fmt.Errorf("downloading bytes %d-%d: %+v", start, end, err)
+		}
+		if actual := int64(len(result.Contents)); actual != end-start+1 {
+			return fmt.Errorf("downloading bytes %d-%d: expected %d bytes but got %d", start, end, end-start+1, actual)
+		}
+
+		hash.Write(result.Contents)
+	}
+
+	actualContentMD5 := base64.StdEncoding.EncodeToString(hash.Sum(nil))
+	if actualContentMD5 != expectedContentMD5 {
+		return fmt.Errorf("the MD5 of the contents (%q) doesn't match the stored Content MD5 (%q)", actualContentMD5, expectedContentMD5)
+	}
+
+	return nil
+}
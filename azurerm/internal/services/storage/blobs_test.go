@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+)
+
+type fakeBlobContentsGetter struct {
+	contents []byte
+	err      error
+
+	// truncate causes each ranged read to return one byte fewer than requested
+	truncate bool
+}
+
+func (f fakeBlobContentsGetter) Get(_ context.Context, _, _, _ string, input blobs.GetInput) (blobs.GetResult, error) {
+	if f.err != nil {
+		return blobs.GetResult{}, f.err
+	}
+	if input.StartByte == nil || input.EndByte == nil {
+		return blobs.GetResult{}, fmt.Errorf("expected a ranged read")
+	}
+
+	end := *input.EndByte + 1
+	if f.truncate {
+		end--
+	}
+
+	return blobs.GetResult{
+		Contents: f.contents[*input.StartByte:end],
+	}, nil
+}
+
+func TestVerifyBlobContentMD5(t *testing.T) {
+	contentMD5 := func(input []byte) string {
+		sum := md5.Sum(input)
+		return base64.StdEncoding.EncodeToString(sum[:])
+	}
+
+	contents := []byte("hello world")
+	largeContents := bytes.Repeat([]byte("abcdefgh"), int(blobContentMD5ChunkSize/4)+3)
+
+	tests := []struct {
+		name        string
+		client      fakeBlobContentsGetter
+		expectedMD5 string
+		shouldError bool
+	}{
+		{
+			name:        "Matching MD5",
+			client:      fakeBlobContentsGetter{contents: contents},
+			expectedMD5: contentMD5(contents),
+			shouldError: false,
+		},
+		{
+			name:        "Matching MD5 across multiple chunks",
+			client:      fakeBlobContentsGetter{contents: largeContents},
+			expectedMD5: contentMD5(largeContents),
+			shouldError: false,
+		},
+		{
+			name:        "Empty Blob",
+			client:      fakeBlobContentsGetter{contents: []byte{}},
+			expectedMD5: contentMD5([]byte{}),
+			shouldError: false,
+		},
+		{
+			name:        "Mismatched MD5",
+			client:      fakeBlobContentsGetter{contents: []byte("goodbye world")},
+			expectedMD5: contentMD5(contents),
+			shouldError: true,
+		},
+		{
+			name:        "Mismatched MD5 across multiple chunks",
+			client:      fakeBlobContentsGetter{contents: largeContents},
+			expectedMD5: contentMD5(largeContents[1:]),
+			shouldError: true,
+		},
+		{
+			name:        "Short Read",
+			client:      fakeBlobContentsGetter{contents: contents, truncate: true},
+			expectedMD5: contentMD5(contents),
+			shouldError: true,
+		},
+		{
+			name:        "Download Error",
+			client:      fakeBlobContentsGetter{contents: contents, err: fmt.Errorf("boom")},
+			expectedMD5: contentMD5(contents),
+			shouldError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			contentLength := int64(len(test.client.contents))
+			err := verifyBlobContentMD5(context.TODO(), test.client, "account", "container", "blob.txt", contentLength, test.expectedMD5)
+			if test.shouldError && err == nil {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+			if !test.shouldError && err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+		})
+	}
+}
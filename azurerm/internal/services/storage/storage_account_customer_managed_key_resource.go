@@ -138,22 +138,7 @@ func resourceStorageAccountCustomerManagedKeyCreateUpdate(d *schema.ResourceData
 
 	props := storage.AccountUpdateParameters{
 		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
-			Encryption: &storage.Encryption{
-				Services: &storage.EncryptionServices{
-					Blob: &storage.EncryptionService{
-						Enabled: utils.Bool(true),
-					},
-					File: &storage.EncryptionService{
-						Enabled: utils.Bool(true),
-					},
-				},
-				KeySource: storage.KeySourceMicrosoftKeyvault,
-				KeyVaultProperties: &storage.KeyVaultProperties{
-					KeyName:     utils.String(keyName),
-					KeyVersion:  utils.String(keyVersion),
-					KeyVaultURI: utils.String(*keyVaultBaseURL),
-				},
-			},
+			Encryption: expandStorageAccountCustomerManagedKey(*keyVaultBaseURL, keyName, keyVersion),
 		},
 	}
 
@@ -281,3 +266,24 @@ func resourceStorageAccountCustomerManagedKeyDelete(d *schema.ResourceData, meta
 
 	return nil
 }
+
+func expandStorageAccountCustomerManagedKey(keyVaultBaseURL, keyName, keyVersion string) *storage.Encryption {
+	return &storage.Encryption{
+		Services: &storage.EncryptionServices{
+			Blob: &storage.EncryptionService{
+				Enabled: utils.Bool(true),
+			},
+			File: &storage.EncryptionService{
+				Enabled: utils.Bool(true),
+			},
+		},
+		KeySource: storage.KeySourceMicrosoftKeyvault,
+		KeyVaultProperties: &storage.KeyVaultProperties{
+			KeyName: utils.String(keyName),
+			// an empty Key Version is sent (rather than omitted) so that switching from a specific version
+			// to the latest version enables Automatic Key Rotation rather than retaining the previous version
+			KeyVersion:  utils.String(keyVersion),
+			KeyVaultURI: utils.String(keyVaultBaseURL),
+		},
+	}
+}
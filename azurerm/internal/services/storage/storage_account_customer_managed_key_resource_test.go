@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	keyVaultParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
 	storageParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -100,6 +102,31 @@ func TestAccStorageAccountCustomerManagedKey_testKeyVersion(t *testing.T) {
 	})
 }
 
+func TestAccStorageAccountCustomerManagedKey_autoKeyRotationNoDrift(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_customer_managed_key", "test")
+	r := StorageAccountCustomerManagedKeyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.autoKeyRotation(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_version").IsEmpty(),
+				data.CheckWithClientForResource(r.rotateKey, "azurerm_key_vault_key.first"),
+			),
+		},
+		{
+			// the Key has now been rotated outside of Terraform, which shouldn't cause a diff
+			Config: r.autoKeyRotation(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_version").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StorageAccountCustomerManagedKeyResource) accountHasDefaultSettings(ctx context.Context, client *clients.Client, state *terraform.InstanceState) error {
 	accountId, err := storageParse.StorageAccountID(state.Attributes["id"])
 	if err != nil {
@@ -167,6 +194,32 @@ func (r StorageAccountCustomerManagedKeyResource) Exists(ctx context.Context, cl
 	return utils.Bool(false), nil
 }
 
+func (r StorageAccountCustomerManagedKeyResource) rotateKey(ctx context.Context, client *clients.Client, state *terraform.InstanceState) error {
+	id, err := keyVaultParse.ParseNestedItemID(state.ID)
+	if err != nil {
+		return err
+	}
+
+	// creating a Key with the same name adds a new version of the existing Key
+	parameters := keyvault.KeyCreateParameters{
+		Kty:     keyvault.RSA,
+		KeySize: utils.Int32(2048),
+		KeyOps: &[]keyvault.JSONWebKeyOperation{
+			keyvault.Decrypt,
+			keyvault.Encrypt,
+			keyvault.Sign,
+			keyvault.UnwrapKey,
+			keyvault.Verify,
+			keyvault.WrapKey,
+		},
+	}
+	if _, err := client.KeyVault.ManagementClient.CreateKey(ctx, id.KeyVaultBaseUrl, id.Name, parameters); err != nil {
+		return fmt.Errorf("rotating Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
 func (r StorageAccountCustomerManagedKeyResource) basic(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -42,6 +43,20 @@ func resourceStorageBlob() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *schema.ResourceDiff, v interface{}) error {
+			if d.Get("verify_md5").(bool) && d.Get("type").(string) != "Block" {
+				return fmt.Errorf("`verify_md5` is only supported when `type` is `Block`")
+			}
+
+			// checked here rather than only during the Read, since otherwise enabling `verify_md5` for an existing
+			// Blob without a Content MD5 would be saved into the state and then fail every subsequent refresh
+			if d.Get("verify_md5").(bool) && d.NewValueKnown("content_md5") && d.Get("content_md5").(string) == "" {
+				return fmt.Errorf("`content_md5` must be set when `verify_md5` is enabled, so that there's a value to verify against")
+			}
+
+			return nil
+		}),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -128,6 +143,12 @@ func resourceStorageBlob() *schema.Resource {
 				ConflictsWith: []string{"source_uri"},
 			},
 
+			"verify_md5": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -348,6 +369,18 @@ func resourceStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("content_md5", contentMD5)
 
+	if d.Get("verify_md5").(bool) {
+		if props.BlobType != blobs.BlockBlob {
+			return fmt.Errorf("Error verifying the Content MD5 for Blob %q (Container %q / Account %q): `verify_md5` is only supported for Block Blobs", id.BlobName, id.ContainerName, id.AccountName)
+		}
+		if props.ContentMD5 == "" {
+			return fmt.Errorf("Error verifying the Content MD5 for Blob %q (Container %q / Account %q): `verify_md5` is enabled but no Content MD5 is stored for this Blob - set `content_md5` so that there's a value to verify against", id.BlobName, id.ContainerName, id.AccountName)
+		}
+		if err := verifyBlobContentMD5(ctx, blobsClient, id.AccountName, id.ContainerName, id.BlobName, props.ContentLength, props.ContentMD5); err != nil {
+			return fmt.Errorf("Error verifying the Content MD5 for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+	}
+
 	d.Set("type", strings.TrimSuffix(string(props.BlobType), "Blob"))
 	d.Set("url", d.Id())
 
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -150,6 +151,52 @@ func TestAccStorageBlob_blockFromInlineContent(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlob_blockVerifyMD5(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.blockVerifyMD5(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("verify_md5").HasValue("true"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "source_content", "type", "verify_md5"),
+	})
+}
+
+func TestAccStorageBlob_blockVerifyMD5WithoutContentMD5(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.blockVerifyMD5WithoutContentMD5(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.blockVerifyMD5WithoutContentMD5(data, true),
+			ExpectError: regexp.MustCompile("`content_md5` must be set when `verify_md5` is enabled"),
+		},
+	})
+}
+
+func TestAccStorageBlob_pageVerifyMD5(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.pageVerifyMD5(data),
+			ExpectError: regexp.MustCompile("`verify_md5` is only supported when `type` is `Block`"),
+		},
+	})
+}
+
 func TestAccStorageBlob_blockFromPublicBlob(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
 	r := StorageBlobResource{}
@@ -634,6 +681,67 @@ resource "azurerm_storage_blob" "test" {
 `, template)
 }
 
+func (r StorageBlobResource) blockVerifyMD5(data acceptance.TestData) string {
+	template := r.template(data, "blob")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "rick.morty"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "Wubba Lubba Dub Dub"
+  content_md5            = md5("Wubba Lubba Dub Dub")
+  verify_md5             = true
+}
+`, template)
+}
+
+func (r StorageBlobResource) blockVerifyMD5WithoutContentMD5(data acceptance.TestData, verifyMD5 bool) string {
+	template := r.template(data, "blob")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "rick.morty"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "Wubba Lubba Dub Dub"
+  verify_md5             = %t
+}
+`, template, verifyMD5)
+}
+
+func (r StorageBlobResource) pageVerifyMD5(data acceptance.TestData) string {
+	template := r.template(data, "private")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Page"
+  size                   = 5120
+  verify_md5             = true
+}
+`, template)
+}
+
 func (r StorageBlobResource) blockFromPublicBlob(data acceptance.TestData) string {
 	template := r.template(data, "blob")
 	return fmt.Sprintf(`
@@ -454,6 +454,12 @@ func schemaAppServiceSiteConfig() *schema.Schema {
 					Optional: true,
 				},
 
+				"vnet_route_all_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
 				"number_of_workers": {
 					Type:         schema.TypeInt,
 					Optional:     true,
@@ -782,6 +788,11 @@ func schemaAppServiceDataSourceSiteConfig() *schema.Schema {
 					Computed: true,
 				},
 
+				"vnet_route_all_enabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+
 				"number_of_workers": {
 					Type:     schema.TypeInt,
 					Computed: true,
@@ -1707,6 +1718,10 @@ func expandAppServiceSiteConfig(input interface{}) (*web.SiteConfig, error) {
 		siteConfig.HealthCheckPath = utils.String(v.(string))
 	}
 
+	if v, ok := config["vnet_route_all_enabled"]; ok {
+		siteConfig.VnetRouteAllEnabled = utils.Bool(v.(bool))
+	}
+
 	if v, ok := config["number_of_workers"]; ok && v.(int) != 0 {
 		siteConfig.NumberOfWorkers = utils.Int32(int32(v.(int)))
 	}
@@ -1824,6 +1839,12 @@ func flattenAppServiceSiteConfig(input *web.SiteConfig) []interface{} {
 		result["health_check_path"] = *input.HealthCheckPath
 	}
 
+	vnetRouteAllEnabled := false
+	if input.VnetRouteAllEnabled != nil {
+		vnetRouteAllEnabled = *input.VnetRouteAllEnabled
+	}
+	result["vnet_route_all_enabled"] = vnetRouteAllEnabled
+
 	if input.NumberOfWorkers != nil {
 		result["number_of_workers"] = *input.NumberOfWorkers
 	}